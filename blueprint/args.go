@@ -3,6 +3,7 @@ package blueprint
 import (
 	"fmt"
 	"gopkg.in/specgen-io/yaml.v3"
+	"strings"
 )
 
 type Args []NamedArg
@@ -68,6 +69,7 @@ type Arg struct {
 	Description string  `yaml:"description"`
 	NoInput     bool    `yaml:"noinput"`
 	Condition   string  `yaml:"condition"`
+	Env         string  `yaml:"env"`
 	Boolean     *ArgBoolean
 	String      *ArgString
 	Array       *ArgArray
@@ -101,6 +103,10 @@ func (value *Arg) UnmarshalYAML(node *yaml.Node) error {
 		if err != nil {
 			return err
 		}
+		err = validatePossibleValues(node, argString.Values, append([]string{"value"}, NamingVariants...))
+		if err != nil {
+			return err
+		}
 		arg.String = &argString
 		break
 	case ArgTypeBoolean:
@@ -117,6 +123,10 @@ func (value *Arg) UnmarshalYAML(node *yaml.Node) error {
 		if err != nil {
 			return err
 		}
+		err = validatePossibleValues(node, argArray.Values, []string{"value", "values"})
+		if err != nil {
+			return err
+		}
 		arg.Array = &argArray
 		break
 	case ArgTypeGroup:
@@ -135,6 +145,20 @@ func (value *Arg) UnmarshalYAML(node *yaml.Node) error {
 	return nil
 }
 
+// NamingVariants are names of naming variants of string values, like {{name.kebab}}.
+var NamingVariants = []string{"camel", "pascal", "snake", "kebab", "upper_snake", "lower", "upper", "title", "dot_to_slash"}
+
+// validatePossibleValues rejects possible values that clash with the names of fields of the argument value:
+// possible values are populated as boolean flags next to them, like {{build.maven}}.
+func validatePossibleValues(node *yaml.Node, possibleValues []string, reserved []string) error {
+	for _, possibleValue := range possibleValues {
+		if contains(reserved, possibleValue) {
+			return yamlError(node, fmt.Sprintf(`possible value "%s" clashes with the value field of the same name, reserved names are: %s`, possibleValue, strings.Join(reserved, ", ")))
+		}
+	}
+	return nil
+}
+
 func (arg *NamedArg) Type() ArgType {
 	if arg.String != nil {
 		return ArgTypeString
@@ -163,6 +187,11 @@ const (
 type ArgString struct {
 	Values  []string `yaml:"values"`
 	Default *string  `yaml:"default"`
+	Value   *string  `yaml:"value"`
+}
+
+func (arg *ArgString) Computed() bool {
+	return arg.Value != nil
 }
 
 type ArgArray struct {
@@ -191,7 +220,23 @@ func StringArg(description string, noinput bool, condition string, values []stri
 		Description: description,
 		NoInput:     noinput,
 		Condition:   condition,
-		String:      &ArgString{values, defaultValue},
+		String:      &ArgString{Values: values, Default: defaultValue},
+	}
+}
+
+func NamedComputedArg(name string, description string, condition string, value string) NamedArg {
+	return NamedArg{
+		Name: name,
+		Arg:  ComputedArg(description, condition, value),
+	}
+}
+
+func ComputedArg(description string, condition string, value string) Arg {
+	return Arg{
+		Type:        ArgTypeString,
+		Description: description,
+		Condition:   condition,
+		String:      &ArgString{Value: &value},
 	}
 }
 
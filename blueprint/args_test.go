@@ -3,6 +3,7 @@ package blueprint
 import (
 	"github.com/google/go-cmp/cmp"
 	"gopkg.in/specgen-io/yaml.v3"
+	"gotest.tools/v3/assert"
 	"strings"
 	"testing"
 )
@@ -29,6 +30,15 @@ default: yes
 `,
 		BooleanArg("the description", false, "", BoolPtr(true)),
 	},
+	{
+		"computed arg",
+		`
+type: string
+description: the description
+value: "{{name.value}}-service"
+`,
+		ComputedArg("the description", "", "{{name.value}}-service"),
+	},
 	{
 		"array arg",
 		`
@@ -108,6 +118,14 @@ func Test_ArgUnmarshal(t *testing.T) {
 	}
 }
 
+func Test_ArgUnmarshalReservedValues(t *testing.T) {
+	arg := Arg{}
+	err := yaml.Unmarshal([]byte("type: string\nvalues: [upper, lower]"), &arg)
+	assert.ErrorContains(t, err, `possible value "upper" clashes with the value field of the same name`)
+	err = yaml.Unmarshal([]byte("type: array\nvalues: [a, values]"), &arg)
+	assert.ErrorContains(t, err, `possible value "values" clashes with the value field of the same name`)
+}
+
 type ArgUnmarshalTestCase struct {
 	Name     string
 	Yaml     string
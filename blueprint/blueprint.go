@@ -1,6 +1,7 @@
 package blueprint
 
 import (
+	"fmt"
 	"gopkg.in/specgen-io/yaml.v3"
 	"strings"
 )
@@ -29,16 +30,50 @@ func (arr PathArray) Contains(value string) bool {
 	return false
 }
 
+const BuiltinArgName = "rendr"
+
+const DefaultPartials = "_partials"
+
+const IgnoreFilename = ".rendrignore"
+
+const DefaultBlueprintFilename = "rendr.yaml"
+
 type Blueprint struct {
 	Blueprint       string            `yaml:"rendr"`
 	Name            string            `yaml:"name"`
 	Title           string            `yaml:"title"`
-	Roots           []string          `yaml:"roots"`
+	Namespace       string            `yaml:"namespace"`
+	Extends         string            `yaml:"extends"`
+	Roots           []Root            `yaml:"roots"`
 	Args            Args              `yaml:"args"`
+	Presets         Presets           `yaml:"presets"`
 	StaticPaths     PathArray         `yaml:"static"`
 	IgnorePaths     PathArray         `yaml:"ignore"`
-	ExecutablePaths PathArray         `yaml:"executables"`
+	ExecutablePaths Executables       `yaml:"executables"`
+	BinaryPaths     PathArray         `yaml:"binary"`
+	TextPaths       PathArray         `yaml:"text"`
+	PrefixPaths     bool              `yaml:"prefix_paths"`
+	IgnoreFilePaths PathArray         `yaml:"-"`
 	Rename          map[string]string `yaml:"rename"`
+	Partials        string            `yaml:"partials"`
+	Engine          EngineKind        `yaml:"engine"`
+	Escape          Escape            `yaml:"escape"`
+	Delimiters      Delimiters        `yaml:"delimiters"`
+	Paths           PathsSettings     `yaml:"paths"`
+	Templates       []SubTemplate     `yaml:"templates"`
+	Hooks           Hooks             `yaml:"hooks"`
+	Message         string            `yaml:"message"`
+	NextSteps       []string          `yaml:"next_steps"`
+	// PartialsSources are partials folders of the blueprint and blueprints it extends in the lookup order.
+	PartialsSources []PartialsSource `yaml:"-"`
+	// Remote is set when the blueprint or any blueprint it extends is loaded from the remote source.
+	Remote bool `yaml:"-"`
+}
+
+// PartialsSource is the partials folder at the path inside of the template source.
+type PartialsSource struct {
+	Source string
+	Path   string
 }
 
 func Read(blueprintContent string) (*Blueprint, error) {
@@ -47,5 +82,24 @@ func Read(blueprintContent string) (*Blueprint, error) {
 	if err != nil {
 		return nil, err
 	}
+	if blueprint.Args.FindByName(BuiltinArgName) != nil {
+		return nil, fmt.Errorf(`argument name "%s" is reserved for built-in values`, BuiltinArgName)
+	}
+	err = blueprint.Engine.validate()
+	if err != nil {
+		return nil, err
+	}
+	err = blueprint.Escape.validate()
+	if err != nil {
+		return nil, err
+	}
+	err = blueprint.Delimiters.validate()
+	if err != nil {
+		return nil, err
+	}
+	err = blueprint.Paths.validate()
+	if err != nil {
+		return nil, err
+	}
 	return &blueprint, nil
 }
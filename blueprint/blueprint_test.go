@@ -3,6 +3,7 @@ package blueprint
 import (
 	"github.com/google/go-cmp/cmp"
 	"gopkg.in/specgen-io/yaml.v3"
+	"gotest.tools/v3/assert"
 	"strings"
 	"testing"
 )
@@ -43,6 +44,26 @@ args:
 			},
 		},
 	},
+	{
+		"blueprint with roots",
+		`
+rendr: 0
+name: sample blueprint
+roots:
+  - base
+  - path: docker
+    condition: "{{#docker.value}}"
+    target: deploy/
+`,
+		Blueprint{
+			Blueprint: "0",
+			Name:      "sample blueprint",
+			Roots: []Root{
+				{Path: "base"},
+				{Path: "docker", Condition: "{{#docker.value}}", Target: "deploy/"},
+			},
+		},
+	},
 }
 
 func Test_BlueprintUnmarshal(t *testing.T) {
@@ -56,6 +77,17 @@ func Test_BlueprintUnmarshal(t *testing.T) {
 	}
 }
 
+func Test_ReadReservedArgName(t *testing.T) {
+	_, err := Read(`
+rendr: 0
+name: sample blueprint
+args:
+  rendr:
+    type: string
+`)
+	assert.Error(t, err, `argument name "rendr" is reserved for built-in values`)
+}
+
 type BlueprintUnmarshalTestCase struct {
 	Name     string
 	Yaml     string
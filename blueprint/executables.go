@@ -0,0 +1,94 @@
+package blueprint
+
+import (
+	"fmt"
+	"gopkg.in/specgen-io/yaml.v3"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+const DefaultExecutableMode fs.FileMode = 0755
+
+type Executable struct {
+	Pattern string
+	Mode    fs.FileMode
+}
+
+// Executables are declared as list of globs marking files with default executable mode 0755
+// or as globs mapped to explicit octal mode: - "bin/**": "0750"
+type Executables []Executable
+
+func (value *Executables) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.SequenceNode {
+		return yamlError(node, "executables should be YAML sequence")
+	}
+	array := Executables{}
+	for _, itemNode := range node.Content {
+		executable, err := unmarshalExecutable(itemNode)
+		if err != nil {
+			return err
+		}
+		array = append(array, *executable)
+	}
+	*value = array
+	return nil
+}
+
+func unmarshalExecutable(node *yaml.Node) (*Executable, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return &Executable{node.Value, DefaultExecutableMode}, nil
+	case yaml.MappingNode:
+		if len(node.Content) != 2 || node.Content[1].Kind != yaml.ScalarNode {
+			return nil, yamlError(node, "executable should be glob or glob mapped to octal mode")
+		}
+		mode, err := parseMode(node.Content[1].Value)
+		if err != nil {
+			return nil, yamlError(node.Content[1], err.Error())
+		}
+		return &Executable{node.Content[0].Value, mode}, nil
+	}
+	return nil, yamlError(node, "executable should be glob or glob mapped to octal mode")
+}
+
+func parseMode(value string) (fs.FileMode, error) {
+	mode, err := strconv.ParseUint(strings.TrimPrefix(value, "0o"), 8, 32)
+	if err != nil || mode > 0777 {
+		return 0, fmt.Errorf(`mode should be octal permissions like "0755", found: "%s"`, value)
+	}
+	return fs.FileMode(mode), nil
+}
+
+// Mode returns mode of the executable file matching the path, last matching declaration wins.
+// Pattern starting with "!" excludes the path from executables.
+func (executables Executables) Mode(filePath string) (fs.FileMode, bool) {
+	var mode fs.FileMode = 0
+	found := false
+	for _, executable := range executables {
+		if strings.HasPrefix(executable.Pattern, "!") {
+			if MatchGlob(strings.TrimPrefix(executable.Pattern, "!"), filePath) {
+				mode = 0
+				found = false
+			}
+		} else if MatchGlob(executable.Pattern, filePath) {
+			mode = executable.Mode
+			found = true
+		}
+	}
+	return mode, found
+}
+
+// ModeByPath returns mode of the executable file with exactly the same path as declared,
+// this is how executables were matched before glob patterns were introduced.
+func (executables Executables) ModeByPath(filePath string) (fs.FileMode, bool) {
+	var mode fs.FileMode = 0
+	found := false
+	for _, executable := range executables {
+		if executable.Pattern == filePath {
+			mode = executable.Mode
+			found = true
+		}
+	}
+	return mode, found
+}
@@ -0,0 +1,72 @@
+package blueprint
+
+import (
+	"gopkg.in/specgen-io/yaml.v3"
+	"gotest.tools/v3/assert"
+	"io/fs"
+	"strings"
+	"testing"
+)
+
+func Test_ExecutablesUnmarshal(t *testing.T) {
+	data := `
+executables:
+  - mvnw
+  - "scripts/*.sh"
+  - "bin/**": "0750"
+  - "bin/public": 0755
+`
+	blueprint := Blueprint{}
+	err := yaml.Unmarshal([]byte(strings.TrimSpace(data)), &blueprint)
+	assert.NilError(t, err)
+	expected := Executables{
+		{"mvnw", 0755},
+		{"scripts/*.sh", 0755},
+		{"bin/**", 0750},
+		{"bin/public", 0755},
+	}
+	assert.DeepEqual(t, blueprint.ExecutablePaths, expected)
+}
+
+func Test_ExecutablesUnmarshalWrongMode(t *testing.T) {
+	data := `
+executables:
+  - "bin/**": "rwx"
+`
+	blueprint := Blueprint{}
+	err := yaml.Unmarshal([]byte(strings.TrimSpace(data)), &blueprint)
+	assert.ErrorContains(t, err, `mode should be octal permissions like "0755", found: "rwx"`)
+}
+
+var casesExecutablesMode = []ExecutablesModeTestCase{
+	{"exact name", "mvnw", true, 0755},
+	{"name at any level", "server/mvnw", true, 0755},
+	{"glob", "scripts/build.sh", true, 0755},
+	{"explicit mode", "bin/tool", true, 0750},
+	{"last match wins", "bin/public", true, 0755},
+	{"negation", "bin/internal/tool", false, 0},
+	{"not executable", "README.md", false, 0},
+}
+
+func Test_ExecutablesMode(t *testing.T) {
+	executables := Executables{
+		{"mvnw", 0755},
+		{"scripts/*.sh", 0755},
+		{"bin/**", 0750},
+		{"bin/public", 0755},
+		{"!bin/internal/", 0755},
+	}
+	for _, testcase := range casesExecutablesMode {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		mode, found := executables.Mode(testcase.Path)
+		assert.Equal(t, found, testcase.Found)
+		assert.Equal(t, mode, testcase.Mode)
+	}
+}
+
+type ExecutablesModeTestCase struct {
+	Name  string
+	Path  string
+	Found bool
+	Mode  fs.FileMode
+}
@@ -0,0 +1,79 @@
+package blueprint
+
+// Extend merges child blueprint declaring extends into its parent blueprint.
+// Child inherits everything from the parent: arguments and presets with the same name are overridden by the child
+// and new arguments are added after the parent ones, lists of roots and paths patterns are concatenated,
+// so child patterns are checked after the parent ones, renames are merged and child settings win when set,
+// including message and next steps. Partials are looked up in the child first, so child partials override the parent ones.
+func Extend(parent *Blueprint, child *Blueprint) *Blueprint {
+	result := *child
+	result.Args = extendArgs(parent.Args, child.Args)
+	result.Roots = append(append([]Root{}, parent.Roots...), child.Roots...)
+	result.StaticPaths = append(append(PathArray{}, parent.StaticPaths...), child.StaticPaths...)
+	result.IgnorePaths = append(append(PathArray{}, parent.IgnorePaths...), child.IgnorePaths...)
+	result.IgnoreFilePaths = append(append(PathArray{}, parent.IgnoreFilePaths...), child.IgnoreFilePaths...)
+	result.ExecutablePaths = append(append(Executables{}, parent.ExecutablePaths...), child.ExecutablePaths...)
+	result.BinaryPaths = append(append(PathArray{}, parent.BinaryPaths...), child.BinaryPaths...)
+	result.TextPaths = append(append(PathArray{}, parent.TextPaths...), child.TextPaths...)
+	result.Paths = append(append(PathsSettings{}, parent.Paths...), child.Paths...)
+	result.Presets = extendPresets(parent.Presets, child.Presets)
+	result.Templates = append(append([]SubTemplate{}, parent.Templates...), child.Templates...)
+	result.Hooks.PreRender = append(append([]string{}, parent.Hooks.PreRender...), child.Hooks.PreRender...)
+	result.Hooks.PostRender = append(append([]string{}, parent.Hooks.PostRender...), child.Hooks.PostRender...)
+	result.PartialsSources = append(append([]PartialsSource{}, child.PartialsSources...), parent.PartialsSources...)
+	result.Remote = parent.Remote || child.Remote
+	result.Rename = map[string]string{}
+	for source, target := range parent.Rename {
+		result.Rename[source] = target
+	}
+	for source, target := range child.Rename {
+		result.Rename[source] = target
+	}
+	if result.Name == "" {
+		result.Name = parent.Name
+	}
+	if result.Title == "" {
+		result.Title = parent.Title
+	}
+	if result.Engine == "" {
+		result.Engine = parent.Engine
+	}
+	if result.Escape == "" {
+		result.Escape = parent.Escape
+	}
+	if result.Delimiters == "" {
+		result.Delimiters = parent.Delimiters
+	}
+	if result.Message == "" {
+		result.Message = parent.Message
+	}
+	if result.NextSteps == nil {
+		result.NextSteps = parent.NextSteps
+	}
+	result.PrefixPaths = parent.PrefixPaths || child.PrefixPaths
+	return &result
+}
+
+func extendArgs(parentArgs Args, childArgs Args) Args {
+	result := append(Args{}, parentArgs...)
+	for _, arg := range childArgs {
+		if existing := result.FindByName(arg.Name); existing != nil {
+			*existing = arg
+		} else {
+			result = append(result, arg)
+		}
+	}
+	return result
+}
+
+func extendPresets(parentPresets Presets, childPresets Presets) Presets {
+	result := append(Presets{}, parentPresets...)
+	for _, preset := range childPresets {
+		if existing := result.Find(preset.Name); existing != nil {
+			*existing = preset
+		} else {
+			result = append(result, preset)
+		}
+	}
+	return result
+}
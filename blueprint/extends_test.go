@@ -0,0 +1,59 @@
+package blueprint
+
+import (
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+func Test_Extend(t *testing.T) {
+	parent := &Blueprint{
+		Name:  "base",
+		Title: "Base template",
+		Args: Args{
+			NamedStringArg("name", "service name", false, "", nil, nil),
+			NamedBooleanArg("docker", "use docker", false, "", nil),
+		},
+		Roots:           []Root{{Path: ".", Source: "file:///templates/base"}},
+		IgnorePaths:     PathArray{"/rendr.yaml", "docs/"},
+		Rename:          map[string]string{"gitignore": ".gitignore", "env": ".env"},
+		Escape:          EscapeNone,
+		PartialsSources: []PartialsSource{{"file:///templates/base", "_partials"}},
+	}
+	child := &Blueprint{
+		Name:    "service",
+		Extends: "../base",
+		Args: Args{
+			NamedBooleanArg("docker", "use docker", false, "", BoolPtr(true)),
+			NamedStringArg("port", "port", false, "", nil, StrPtr("8080")),
+		},
+		Roots:           []Root{{Path: "."}},
+		IgnorePaths:     PathArray{"/rendr.yaml", "!docs/README.md"},
+		Rename:          map[string]string{"env": "service.env"},
+		PartialsSources: []PartialsSource{{"file:///templates/service", "_partials"}},
+	}
+	expected := &Blueprint{
+		Name:    "service",
+		Title:   "Base template",
+		Extends: "../base",
+		Args: Args{
+			NamedStringArg("name", "service name", false, "", nil, nil),
+			NamedBooleanArg("docker", "use docker", false, "", BoolPtr(true)),
+			NamedStringArg("port", "port", false, "", nil, StrPtr("8080")),
+		},
+		Roots:           []Root{{Path: ".", Source: "file:///templates/base"}, {Path: "."}},
+		StaticPaths:     PathArray{},
+		IgnorePaths:     PathArray{"/rendr.yaml", "docs/", "/rendr.yaml", "!docs/README.md"},
+		IgnoreFilePaths: PathArray{},
+		ExecutablePaths: Executables{},
+		BinaryPaths:     PathArray{},
+		TextPaths:       PathArray{},
+		Paths:           PathsSettings{},
+		Presets:         Presets{},
+		Templates:       []SubTemplate{},
+		Hooks:           Hooks{PreRender: []string{}, PostRender: []string{}},
+		Rename:          map[string]string{"gitignore": ".gitignore", "env": "service.env"},
+		Escape:          EscapeNone,
+		PartialsSources: []PartialsSource{{"file:///templates/service", "_partials"}, {"file:///templates/base", "_partials"}},
+	}
+	assert.DeepEqual(t, Extend(parent, child), expected)
+}
@@ -0,0 +1,64 @@
+package blueprint
+
+import (
+	"regexp"
+	"strings"
+)
+
+// MatchGlob checks if the path matches glob pattern.
+// Supported wildcards: "**" matches any number of folders, "*" matches any characters except "/"
+// and "?" matches any single character except "/".
+// Pattern without "/" is matched against the name of the file or folder at any level,
+// leading "/" anchors the pattern to the root.
+func MatchGlob(pattern string, path string) bool {
+	if !strings.Contains(strings.TrimSuffix(pattern, "/"), "/") {
+		pattern = "**/" + pattern
+	}
+	pattern = strings.TrimPrefix(pattern, "/")
+	return globRegexp(pattern).MatchString(path)
+}
+
+// MatchesPatterns checks if the path matches gitignore style patterns.
+// Patterns are checked in the order of declaration and the last matching one wins,
+// pattern starting with "!" negates the match of previous patterns.
+func (arr PathArray) MatchesPatterns(value string) bool {
+	matches := false
+	for _, pattern := range arr {
+		if strings.HasPrefix(pattern, "!") {
+			if MatchGlob(strings.TrimPrefix(pattern, "!"), value) {
+				matches = false
+			}
+		} else if MatchGlob(pattern, value) {
+			matches = true
+		}
+	}
+	return matches
+}
+
+func globRegexp(pattern string) *regexp.Regexp {
+	var builder strings.Builder
+	builder.WriteString("^")
+	for index := 0; index < len(pattern); index++ {
+		switch {
+		case strings.HasPrefix(pattern[index:], "**/"):
+			builder.WriteString("(.*/)?")
+			index += 2
+		case strings.HasPrefix(pattern[index:], "**"):
+			builder.WriteString(".*")
+			index += 1
+		case pattern[index] == '*':
+			builder.WriteString("[^/]*")
+		case pattern[index] == '?':
+			builder.WriteString("[^/]")
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(pattern[index])))
+		}
+	}
+	if strings.HasSuffix(pattern, "/") {
+		builder.WriteString(".*")
+	} else {
+		builder.WriteString("(/.*)?")
+	}
+	builder.WriteString("$")
+	return regexp.MustCompile(builder.String())
+}
@@ -0,0 +1,66 @@
+package blueprint
+
+import (
+	"testing"
+)
+
+var casesMatchGlob = []MatchGlobTestCase{
+	{"exact path", "docs/readme.md", "docs/readme.md", true},
+	{"folder prefix", "charts", "charts/values.yaml", true},
+	{"folder is not prefix of name", "doc", "docker/Dockerfile", false},
+	{"double star folder", "charts/**", "charts/templates/deployment.yaml", true},
+	{"double star any level", "**/*.png", "assets/img/logo.png", true},
+	{"double star root level", "**/*.png", "logo.png", true},
+	{"star does not cross folders", "src/*.java", "src/main/Main.java", false},
+	{"star in folder", "src/*.java", "src/Main.java", true},
+	{"name pattern at any level", "*.html", "web/index.html", true},
+	{"question mark", "file?.txt", "file1.txt", true},
+	{"question mark not empty", "file?.txt", "file.txt", false},
+	{"anchored pattern", "/build.gradle", "sub/build.gradle", false},
+	{"special characters", "{{#docker.value}}/Dockerfile", "{{#docker.value}}/Dockerfile", true},
+}
+
+func Test_MatchGlob(t *testing.T) {
+	for _, testcase := range casesMatchGlob {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		actual := MatchGlob(testcase.Pattern, testcase.Path)
+		if actual != testcase.Expected {
+			t.Errorf("Failed, pattern %s matching %s\nexpected: %v\nactual:   %v", testcase.Pattern, testcase.Path, testcase.Expected, actual)
+		}
+	}
+}
+
+type MatchGlobTestCase struct {
+	Name     string
+	Pattern  string
+	Path     string
+	Expected bool
+}
+
+var casesMatchesPatterns = []MatchesPatternsTestCase{
+	{"no patterns", PathArray{}, "doc/readme.md", false},
+	{"name at any level", PathArray{"*.log"}, "sub/debug.log", true},
+	{"name is not prefix", PathArray{"doc"}, "docker/Dockerfile", false},
+	{"folder only", PathArray{"doc/"}, "doc", false},
+	{"folder content", PathArray{"doc/"}, "doc/readme.md", true},
+	{"negation", PathArray{"doc/", "!doc/keep.md"}, "doc/keep.md", false},
+	{"negation keeps other matches", PathArray{"doc/", "!doc/keep.md"}, "doc/readme.md", true},
+	{"last pattern wins", PathArray{"!doc/keep.md", "doc/"}, "doc/keep.md", true},
+}
+
+func Test_MatchesPatterns(t *testing.T) {
+	for _, testcase := range casesMatchesPatterns {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		actual := testcase.Patterns.MatchesPatterns(testcase.Path)
+		if actual != testcase.Expected {
+			t.Errorf("Failed, patterns %v matching %s\nexpected: %v\nactual:   %v", testcase.Patterns, testcase.Path, testcase.Expected, actual)
+		}
+	}
+}
+
+type MatchesPatternsTestCase struct {
+	Name     string
+	Patterns PathArray
+	Path     string
+	Expected bool
+}
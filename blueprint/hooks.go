@@ -0,0 +1,7 @@
+package blueprint
+
+// Hooks are commands run in the output folder before and after rendered files are written.
+type Hooks struct {
+	PreRender  []string `yaml:"pre_render"`
+	PostRender []string `yaml:"post_render"`
+}
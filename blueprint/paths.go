@@ -0,0 +1,177 @@
+package blueprint
+
+import (
+	"fmt"
+	"gopkg.in/specgen-io/yaml.v3"
+	"io/fs"
+	"path"
+	"strings"
+)
+
+type Escape string
+
+const (
+	EscapeNone  Escape = "none"
+	EscapeHtml  Escape = "html"
+	EscapeJson  Escape = "json"
+	EscapeYaml  Escape = "yaml"
+	EscapeXml   Escape = "xml"
+	EscapeShell Escape = "shell"
+)
+
+func (value Escape) validate() error {
+	switch value {
+	case "", EscapeNone, EscapeHtml, EscapeJson, EscapeYaml, EscapeXml, EscapeShell:
+		return nil
+	}
+	return fmt.Errorf(`unknown escape: "%s"`, value)
+}
+
+type EngineKind string
+
+const (
+	EngineMustache   EngineKind = "mustache"
+	EngineGoTemplate EngineKind = "gotemplate"
+)
+
+func (value EngineKind) validate() error {
+	switch value {
+	case "", EngineMustache, EngineGoTemplate:
+		return nil
+	}
+	return fmt.Errorf(`unknown engine: "%s"`, value)
+}
+
+type Delimiters string
+
+const DefaultDelimiters Delimiters = "{{ }}"
+
+func (value Delimiters) validate() error {
+	if value == "" {
+		return nil
+	}
+	parts := strings.Split(string(value), " ")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.Contains(string(value), "=") {
+		return fmt.Errorf(`delimiters should be opening and closing tags separated by space, like "<%% %%>", found: "%s"`, value)
+	}
+	return nil
+}
+
+type PathSettings struct {
+	Engine     EngineKind `yaml:"engine"`
+	Escape     Escape     `yaml:"escape"`
+	Delimiters Delimiters `yaml:"delimiters"`
+}
+
+type PatternPathSettings struct {
+	Pattern string
+	PathSettings
+}
+
+type PathsSettings []PatternPathSettings
+
+func (value *PathsSettings) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return yamlError(node, "paths should be YAML mapping")
+	}
+	count := len(node.Content) / 2
+	array := PathsSettings{}
+	for index := 0; index < count; index++ {
+		keyNode := node.Content[index*2]
+		valueNode := node.Content[index*2+1]
+		var pattern string
+		err := keyNode.DecodeWith(decodeStrict, &pattern)
+		if err != nil {
+			return err
+		}
+		settings := PathSettings{}
+		err = valueNode.DecodeWith(decodeStrict, &settings)
+		if err != nil {
+			return err
+		}
+		array = append(array, PatternPathSettings{pattern, settings})
+	}
+	*value = array
+	return nil
+}
+
+func (value PathsSettings) validate() error {
+	for _, settings := range value {
+		err := settings.Engine.validate()
+		if err != nil {
+			return fmt.Errorf(`paths "%s": %s`, settings.Pattern, err.Error())
+		}
+		err = settings.Escape.validate()
+		if err != nil {
+			return fmt.Errorf(`paths "%s": %s`, settings.Pattern, err.Error())
+		}
+		err = settings.Delimiters.validate()
+		if err != nil {
+			return fmt.Errorf(`paths "%s": %s`, settings.Pattern, err.Error())
+		}
+	}
+	return nil
+}
+
+// GetPathSettings returns settings for the template file path.
+// Blueprint level settings are overridden by settings of all matching path patterns in the order of declaration.
+func (blueprint *Blueprint) GetPathSettings(filePath string) PathSettings {
+	result := PathSettings{Engine: blueprint.Engine, Escape: blueprint.Escape, Delimiters: blueprint.Delimiters}
+	for _, settings := range blueprint.Paths {
+		if MatchGlob(settings.Pattern, filePath) {
+			if settings.Engine != "" {
+				result.Engine = settings.Engine
+			}
+			if settings.Escape != "" {
+				result.Escape = settings.Escape
+			}
+			if settings.Delimiters != "" {
+				result.Delimiters = settings.Delimiters
+			}
+		}
+	}
+	if result.Engine == "" {
+		result.Engine = EngineMustache
+	}
+	if result.Escape == "" {
+		result.Escape = defaultEscape(filePath)
+	}
+	if result.Delimiters == "" {
+		result.Delimiters = DefaultDelimiters
+	}
+	return result
+}
+
+func defaultEscape(filePath string) Escape {
+	switch path.Ext(filePath) {
+	case ".html", ".htm", ".xhtml":
+		return EscapeHtml
+	}
+	return EscapeNone
+}
+
+// IsIgnored checks if the template file should be excluded from rendering.
+// Ignore paths are gitignore style patterns unless prefix_paths compatibility flag is set.
+func (blueprint *Blueprint) IsIgnored(filePath string) bool {
+	return blueprint.matchPaths(blueprint.IgnorePaths, filePath) || blueprint.IgnoreFilePaths.MatchesPatterns(filePath)
+}
+
+// IsStatic checks if the template file should be copied without rendering.
+func (blueprint *Blueprint) IsStatic(filePath string) bool {
+	return blueprint.matchPaths(blueprint.StaticPaths, filePath)
+}
+
+// GetExecutableMode returns mode of the template file if it is declared as executable.
+func (blueprint *Blueprint) GetExecutableMode(filePath string) (fs.FileMode, bool) {
+	if blueprint.PrefixPaths {
+		return blueprint.ExecutablePaths.ModeByPath(filePath)
+	}
+	return blueprint.ExecutablePaths.Mode(filePath)
+}
+
+func (blueprint *Blueprint) matchPaths(paths PathArray, filePath string) bool {
+	if blueprint.PrefixPaths {
+		return paths.Matches(filePath)
+	}
+	return paths.MatchesPatterns(filePath)
+}
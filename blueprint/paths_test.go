@@ -0,0 +1,134 @@
+package blueprint
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"gotest.tools/v3/assert"
+	"strings"
+	"testing"
+)
+
+func Test_PathsSettingsUnmarshal(t *testing.T) {
+	blueprint, err := Read(strings.TrimSpace(`
+rendr: 0
+name: sample
+escape: yaml
+paths:
+  "**/*.json":
+    escape: json
+  "web/**":
+    escape: html
+`))
+	assert.NilError(t, err)
+	expected := PathsSettings{
+		{"**/*.json", PathSettings{Escape: EscapeJson}},
+		{"web/**", PathSettings{Escape: EscapeHtml}},
+	}
+	if !cmp.Equal(expected, blueprint.Paths) {
+		t.Errorf("Failed, paths do not match\nexpected: %v\nactual:   %v", expected, blueprint.Paths)
+	}
+}
+
+func Test_PathsSettingsWrongDelimiters(t *testing.T) {
+	_, err := Read(strings.TrimSpace(`
+rendr: 0
+name: sample
+delimiters: "<%%>"
+`))
+	assert.Error(t, err, `delimiters should be opening and closing tags separated by space, like "<% %>", found: "<%%>"`)
+}
+
+func Test_PathsSettingsUnknownEscape(t *testing.T) {
+	_, err := Read(strings.TrimSpace(`
+rendr: 0
+name: sample
+paths:
+  "**/*.json":
+    escape: unknown
+`))
+	assert.Error(t, err, `paths "**/*.json": unknown escape: "unknown"`)
+}
+
+var casesGetPathSettings = []GetPathSettingsTestCase{
+	{"default for non html", Blueprint{}, "config/app.yaml", PathSettings{Engine: EngineMustache, Escape: EscapeNone, Delimiters: DefaultDelimiters}},
+	{"default for html", Blueprint{}, "web/index.html", PathSettings{Engine: EngineMustache, Escape: EscapeHtml, Delimiters: DefaultDelimiters}},
+	{"blueprint level", Blueprint{Escape: EscapeXml}, "web/index.html", PathSettings{Engine: EngineMustache, Escape: EscapeXml, Delimiters: DefaultDelimiters}},
+	{
+		"last matching path wins",
+		Blueprint{Escape: EscapeXml, Paths: PathsSettings{
+			{"config/**", PathSettings{Escape: EscapeYaml}},
+			{"*.json", PathSettings{Escape: EscapeJson}},
+		}},
+		"config/app.json",
+		PathSettings{Engine: EngineMustache, Escape: EscapeJson, Delimiters: DefaultDelimiters},
+	},
+	{
+		"not matching path",
+		Blueprint{Paths: PathsSettings{
+			{"config/**", PathSettings{Escape: EscapeYaml}},
+		}},
+		"scripts/run.sh",
+		PathSettings{Engine: EngineMustache, Escape: EscapeNone, Delimiters: DefaultDelimiters},
+	},
+	{
+		"delimiters override",
+		Blueprint{Delimiters: "[[ ]]", Paths: PathsSettings{
+			{"charts/**", PathSettings{Delimiters: "<% %>"}},
+		}},
+		"charts/templates/deployment.yaml",
+		PathSettings{Engine: EngineMustache, Escape: EscapeNone, Delimiters: "<% %>"},
+	},
+	{
+		"blueprint delimiters",
+		Blueprint{Delimiters: "[[ ]]", Paths: PathsSettings{
+			{"charts/**", PathSettings{Delimiters: "<% %>"}},
+		}},
+		"src/main.go",
+		PathSettings{Engine: EngineMustache, Escape: EscapeNone, Delimiters: "[[ ]]"},
+	},
+	{
+		"engine override",
+		Blueprint{Paths: PathsSettings{
+			{"scripts/**", PathSettings{Engine: EngineGoTemplate}},
+		}},
+		"scripts/run.sh",
+		PathSettings{Engine: EngineGoTemplate, Escape: EscapeNone, Delimiters: DefaultDelimiters},
+	},
+}
+
+func Test_GetPathSettings(t *testing.T) {
+	for _, testcase := range casesGetPathSettings {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		actual := testcase.Blueprint.GetPathSettings(testcase.Path)
+		if !cmp.Equal(testcase.Expected, actual) {
+			t.Errorf("Failed, settings do not match\nexpected: %v\nactual:   %v", testcase.Expected, actual)
+		}
+	}
+}
+
+type GetPathSettingsTestCase struct {
+	Name      string
+	Blueprint Blueprint
+	Path      string
+	Expected  PathSettings
+}
+
+func Test_IsIgnored(t *testing.T) {
+	blueprint := Blueprint{IgnorePaths: PathArray{"doc"}, IgnoreFilePaths: PathArray{"*.log"}}
+	assert.Equal(t, blueprint.IsIgnored("doc/readme.md"), true)
+	assert.Equal(t, blueprint.IsIgnored("docker/Dockerfile"), false)
+	assert.Equal(t, blueprint.IsIgnored("sub/debug.log"), true)
+}
+
+func Test_IsIgnoredPrefixPaths(t *testing.T) {
+	blueprint := Blueprint{IgnorePaths: PathArray{"doc"}, PrefixPaths: true}
+	assert.Equal(t, blueprint.IsIgnored("doc/readme.md"), true)
+	assert.Equal(t, blueprint.IsIgnored("docker/Dockerfile"), true)
+}
+
+func Test_GetExecutableModePrefixPaths(t *testing.T) {
+	blueprint := Blueprint{ExecutablePaths: Executables{{"mvnw", 0755}}, PrefixPaths: true}
+	_, found := blueprint.GetExecutableMode("mvnw")
+	assert.Equal(t, found, true)
+	_, found = blueprint.GetExecutableMode("server/mvnw")
+	assert.Equal(t, found, false)
+}
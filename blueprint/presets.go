@@ -0,0 +1,58 @@
+package blueprint
+
+import (
+	"gopkg.in/specgen-io/yaml.v3"
+)
+
+// Preset is named partial set of arguments values covering typical configuration of the template.
+type Preset struct {
+	Name   string
+	Values map[string]interface{}
+}
+
+type Presets []Preset
+
+func (value *Presets) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return yamlError(node, "presets should be YAML mapping")
+	}
+	count := len(node.Content) / 2
+	array := Presets{}
+	for index := 0; index < count; index++ {
+		keyNode := node.Content[index*2]
+		valueNode := node.Content[index*2+1]
+		var name string
+		err := keyNode.DecodeWith(decodeStrict, &name)
+		if err != nil {
+			return err
+		}
+		if valueNode.Kind != yaml.MappingNode {
+			return yamlError(valueNode, "preset values should be YAML mapping")
+		}
+		values := map[string]interface{}{}
+		err = valueNode.DecodeWith(decodeStrict, &values)
+		if err != nil {
+			return err
+		}
+		array = append(array, Preset{name, values})
+	}
+	*value = array
+	return nil
+}
+
+func (presets Presets) Find(name string) *Preset {
+	for index := range presets {
+		if presets[index].Name == name {
+			return &presets[index]
+		}
+	}
+	return nil
+}
+
+func (presets Presets) Names() []string {
+	result := []string{}
+	for _, preset := range presets {
+		result = append(result, preset.Name)
+	}
+	return result
+}
@@ -0,0 +1,37 @@
+package blueprint
+
+import (
+	"gopkg.in/specgen-io/yaml.v3"
+	"gotest.tools/v3/assert"
+	"strings"
+	"testing"
+)
+
+func Test_PresetsUnmarshal(t *testing.T) {
+	data := `
+presets:
+  gradle:
+    build: gradle
+  maven-docker:
+    build: maven
+    docker: true
+`
+	blueprint := Blueprint{}
+	err := yaml.Unmarshal([]byte(strings.TrimSpace(data)), &blueprint)
+	assert.NilError(t, err)
+	expected := Presets{
+		{"gradle", map[string]interface{}{"build": "gradle"}},
+		{"maven-docker", map[string]interface{}{"build": "maven", "docker": true}},
+	}
+	assert.DeepEqual(t, blueprint.Presets, expected)
+}
+
+func Test_PresetsUnmarshalNotMapping(t *testing.T) {
+	data := `
+presets:
+  gradle: true
+`
+	blueprint := Blueprint{}
+	err := yaml.Unmarshal([]byte(strings.TrimSpace(data)), &blueprint)
+	assert.ErrorContains(t, err, `preset values should be YAML mapping`)
+}
@@ -0,0 +1,40 @@
+package blueprint
+
+import (
+	"gopkg.in/specgen-io/yaml.v3"
+)
+
+// Root is the folder of the template rendered into the output.
+// Root is declared either as plain path or as mapping with optional condition and target folder in the output:
+//   - path: docker
+//     condition: "{{#docker.value}}"
+//     target: deploy/
+type Root struct {
+	Path      string `yaml:"path"`
+	Condition string `yaml:"condition"`
+	Target    string `yaml:"target"`
+	// Source is the url of the template the root belongs to, empty for the roots of the rendered template.
+	Source string `yaml:"-"`
+}
+
+type _Root Root
+
+func (value *Root) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode {
+		*value = Root{Path: node.Value}
+		return nil
+	}
+	if node.Kind != yaml.MappingNode {
+		return yamlError(node, "root should be path or mapping with path, condition and target")
+	}
+	root := _Root{}
+	err := node.DecodeWith(decodeStrict, &root)
+	if err != nil {
+		return err
+	}
+	if root.Path == "" {
+		return yamlError(node, "root should have path")
+	}
+	*value = Root(root)
+	return nil
+}
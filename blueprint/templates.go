@@ -0,0 +1,13 @@
+package blueprint
+
+// SubTemplate is another template rendered into the target folder of the output.
+// Values of the sub-template arguments are templates rendered with arguments values of the parent template.
+type SubTemplate struct {
+	Source    string            `yaml:"source"`
+	Target    string            `yaml:"target"`
+	Condition string            `yaml:"condition"`
+	Values    map[string]string `yaml:"values"`
+	// Url and BlueprintPath are resolved from the source relatively to the template declaring the sub-template.
+	Url           string `yaml:"-"`
+	BlueprintPath string `yaml:"-"`
+}
@@ -7,3 +7,12 @@ func StrPtr(value string) *string {
 func BoolPtr(value bool) *bool {
 	return &value
 }
+
+func contains(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
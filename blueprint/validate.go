@@ -0,0 +1,94 @@
+package blueprint
+
+import (
+	"fmt"
+	"github.com/cbroglie/mustache"
+	"strings"
+)
+
+// Validate checks that defaults and computed values of arguments reference only previously declared arguments.
+// Helpers are names of template helpers, like upper, which are allowed as sections: {{#upper}}{{name.value}}{{/upper}}
+func (args Args) Validate(helpers ...string) error {
+	return validateArgs([]string{}, args, helpers)
+}
+
+func validateArgs(path []string, args Args, helpers []string) error {
+	for index, arg := range args {
+		argPath := append(append([]string{}, path...), arg.Name)
+		if arg.String != nil {
+			if arg.String.Default != nil {
+				err := validateReferences(argPath, "default", *arg.String.Default, args, index, helpers)
+				if err != nil {
+					return err
+				}
+			}
+			if arg.String.Value != nil {
+				err := validateReferences(argPath, "value", *arg.String.Value, args, index, helpers)
+				if err != nil {
+					return err
+				}
+			}
+		}
+		if arg.Map != nil {
+			err := validateArgs(argPath, arg.Map.Args, helpers)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateReferences(argPath []string, field string, template string, args Args, argIndex int, helpers []string) error {
+	argFullName := strings.Join(argPath, ".")
+	references, err := templateReferences(template)
+	if err != nil {
+		return fmt.Errorf(`argument "%s" %s is not valid template: %s`, argFullName, field, err.Error())
+	}
+	for _, reference := range references {
+		// helpers sections are not arguments, references inside them are still collected and checked
+		if reference == BuiltinArgName || contains(helpers, reference) {
+			continue
+		}
+		referencedIndex := -1
+		for index := range args {
+			if args[index].Name == reference {
+				referencedIndex = index
+			}
+		}
+		if referencedIndex == -1 {
+			return fmt.Errorf(`argument "%s" %s references unknown argument "%s"`, argFullName, field, reference)
+		}
+		if referencedIndex == argIndex {
+			return fmt.Errorf(`argument "%s" %s references the argument itself`, argFullName, field)
+		}
+		if referencedIndex > argIndex {
+			return fmt.Errorf(`argument "%s" %s references argument "%s" declared after it, arguments can only reference previously declared arguments`, argFullName, field, reference)
+		}
+	}
+	return nil
+}
+
+func templateReferences(template string) ([]string, error) {
+	parsed, err := mustache.ParseString(template)
+	if err != nil {
+		return nil, err
+	}
+	return tagsReferences(parsed.Tags()), nil
+}
+
+func tagsReferences(tags []mustache.Tag) []string {
+	references := []string{}
+	for _, tag := range tags {
+		if tag.Type() == mustache.Partial {
+			continue
+		}
+		if tag.Name() != "." {
+			references = append(references, strings.SplitN(tag.Name(), ".", 2)[0])
+		}
+		if tag.Type() == mustache.Section || tag.Type() == mustache.InvertedSection {
+			references = append(references, tagsReferences(tag.Tags())...)
+		}
+	}
+	return references
+}
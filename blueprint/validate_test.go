@@ -0,0 +1,108 @@
+package blueprint
+
+import (
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+var casesArgsValidate = []ArgsValidateTestCase{
+	{
+		"default references previous argument",
+		Args{
+			NamedStringArg("name", "", false, "", nil, nil),
+			NamedStringArg("package", "", false, "", nil, StrPtr("com.acme.{{name.value}}")),
+		},
+		"",
+	},
+	{
+		"value references previous argument",
+		Args{
+			NamedStringArg("name", "", false, "", nil, nil),
+			NamedComputedArg("artifact", "", "", "{{#name.value}}{{name.value}}-service{{/name.value}}"),
+		},
+		"",
+	},
+	{
+		"default references built-in values",
+		Args{
+			NamedStringArg("copyright", "", false, "", nil, StrPtr("{{rendr.year}} ACME")),
+			NamedComputedArg("author", "", "", "{{rendr.git.user.name}}"),
+		},
+		"",
+	},
+	{
+		"value uses helper",
+		Args{
+			NamedStringArg("name", "", false, "", nil, nil),
+			NamedComputedArg("constant", "", "", "{{#upper}}{{name.value}}{{/upper}}"),
+		},
+		"",
+	},
+	{
+		"value uses helper over following argument",
+		Args{
+			NamedComputedArg("constant", "", "", "{{#upper}}{{later.value}}{{/upper}}"),
+			NamedStringArg("later", "", false, "", nil, nil),
+		},
+		`argument "constant" value references argument "later" declared after it, arguments can only reference previously declared arguments`,
+	},
+	{
+		"value uses unknown section",
+		Args{
+			NamedStringArg("name", "", false, "", nil, nil),
+			NamedComputedArg("constant", "", "", "{{#shout}}{{name.value}}{{/shout}}"),
+		},
+		`argument "constant" value references unknown argument "shout"`,
+	},
+	{
+		"default references following argument",
+		Args{
+			NamedStringArg("package", "", false, "", nil, StrPtr("com.acme.{{name.value}}")),
+			NamedStringArg("name", "", false, "", nil, nil),
+		},
+		`argument "package" default references argument "name" declared after it, arguments can only reference previously declared arguments`,
+	},
+	{
+		"value references following argument in section",
+		Args{
+			NamedStringArg("name", "", false, "", nil, nil),
+			NamedComputedArg("artifact", "", "", "{{#name.value}}{{later.value}}{{/name.value}}"),
+			NamedStringArg("later", "", false, "", nil, nil),
+		},
+		`argument "artifact" value references argument "later" declared after it, arguments can only reference previously declared arguments`,
+	},
+	{
+		"value references itself",
+		Args{
+			NamedComputedArg("artifact", "", "", "{{artifact.value}}-service"),
+		},
+		`argument "artifact" value references the argument itself`,
+	},
+	{
+		"nested default references unknown argument",
+		Args{
+			NamedGroupArg("versions", "", false, "", Args{
+				NamedStringArg("foo", "", false, "", nil, StrPtr("{{bar.value}}")),
+			}),
+		},
+		`argument "versions.foo" default references unknown argument "bar"`,
+	},
+}
+
+func Test_ArgsValidate(t *testing.T) {
+	for _, testcase := range casesArgsValidate {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		err := testcase.Args.Validate("upper", "lower")
+		if testcase.Error != "" {
+			assert.Error(t, err, testcase.Error)
+		} else {
+			assert.NilError(t, err)
+		}
+	}
+}
+
+type ArgsValidateTestCase struct {
+	Name  string
+	Args  Args
+	Error string
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"errors"
+	"github.com/specgen-io/rendr/console"
+	"github.com/specgen-io/rendr/render"
+	"github.com/spf13/cobra"
+	"os"
+	"os/exec"
+)
+
+const AfterRender = "after-render"
+
+func init() {
+	cmdRoot.Flags().Bool(AfterRender, false, `build rendered project with the build command of the artifact right after rendering`)
+	cmdRoot.AddCommand(cmdBuild)
+}
+
+var cmdBuild = &cobra.Command{
+	Use:   "build [dir]",
+	Short: "Build rendered project with the build command from " + render.ArtifactFilename,
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		dir := "."
+		if len(args) > 0 {
+			dir = args[0]
+		}
+		err := buildArtifact(dir)
+		failIfBuildError(err)
+	},
+}
+
+func buildArtifact(dir string) error {
+	artifact, err := render.GetArtifact(dir)
+	if err != nil {
+		return err
+	}
+	return artifact.Build(dir)
+}
+
+// failIfBuildError exits with the exit code of the build command if it failed.
+func failIfBuildError(err error) {
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		console.Error(err, "Build failed")
+		os.Exit(exitErr.ExitCode())
+	}
+	failIfError(err, "Failed to build")
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"github.com/specgen-io/rendr/console"
+	"github.com/specgen-io/rendr/input"
 	"github.com/specgen-io/rendr/render"
 	"github.com/specgen-io/rendr/values"
 	"github.com/spf13/cobra"
@@ -19,18 +20,24 @@ const NoInput = "noinput"
 const ForceInput = "forceinput"
 const NoOverwrites = "nooverwrites"
 const Verbose = "verbose"
+const AllowHooks = "allow-hooks"
+const Preset = "preset"
+const SaveValues = "save-values"
 
 func init() {
 	cobra.OnInitialize()
 	cmdRoot.Flags().String(Blueprint, "rendr.yaml", `blueprint file inside of the template`)
 	cmdRoot.Flags().String(OutPath, ".", `path to output rendered template`)
 	cmdRoot.Flags().StringArray(Set, []string{}, `set arguments overrides in format "arg=value", repeat for setting multiple arguments values`)
-	cmdRoot.Flags().String(Values, "", `path to arguments values file, could json or yaml`)
+	cmdRoot.Flags().StringArray(Values, []string{}, `path to arguments values file, could be json, yaml, toml or .env, "-" reads from stdin, repeat for layering multiple files`)
+	cmdRoot.Flags().String(Preset, "", `name of the blueprint preset with arguments values`)
+	cmdRoot.Flags().String(SaveValues, "", `path to save arguments values used for rendering, the file could be passed to --values for re-running`)
 	cmdRoot.Flags().Bool(NoInput, false, `do not request user input for missing arguments values`)
 	cmdRoot.Flags().Bool(ForceInput, false, `force user input requests even for noinput arguments`)
 	cmdRoot.Flags().Bool(NoOverwrites, false, `do not overwrite files with rendered from template`)
-	cmdRoot.Flags().StringArray(ExtraRoots, []string{}, `extra template root, repeat for setting multiple extra roots`)
+	cmdRoot.Flags().StringArray(ExtraRoots, []string{}, `extra template root in format "url" or "url=target", repeat for setting multiple extra roots`)
 	cmdRoot.Flags().Bool(Verbose, false, `print more logging`)
+	cmdRoot.Flags().Bool(AllowHooks, false, `run hooks of remote templates without confirmation`)
 }
 
 var cmdRoot = &cobra.Command{
@@ -57,9 +64,15 @@ var cmdRoot = &cobra.Command{
 		overrides, err := cmd.Flags().GetStringArray(Set)
 		failIfError(err, `Failed to get "%s" option`, Set)
 
-		valuesFilePath, err := cmd.Flags().GetString(Values)
+		valuesFilesPaths, err := cmd.Flags().GetStringArray(Values)
 		failIfError(err, `Failed to get "%s" option`, Values)
 
+		preset, err := cmd.Flags().GetString(Preset)
+		failIfError(err, `Failed to get "%s" option`, Preset)
+
+		saveValuesPath, err := cmd.Flags().GetString(SaveValues)
+		failIfError(err, `Failed to get "%s" option`, SaveValues)
+
 		noInput, err := cmd.Flags().GetBool(NoInput)
 		failIfError(err, `Failed to get "%s" option`, NoInput)
 
@@ -72,6 +85,12 @@ var cmdRoot = &cobra.Command{
 		extraRoots, err := cmd.Flags().GetStringArray(ExtraRoots)
 		failIfError(err, `Failed to get "%s" option`, ExtraRoots)
 
+		allowHooks, err := cmd.Flags().GetBool(AllowHooks)
+		failIfError(err, `Failed to get "%s" option`, AllowHooks)
+
+		afterRender, err := cmd.Flags().GetBool(AfterRender)
+		failIfError(err, `Failed to get "%s" option`, AfterRender)
+
 		inputMode := render.RegularInputMode
 		if forceInput {
 			inputMode = render.ForceInputMode
@@ -80,15 +99,41 @@ var cmdRoot = &cobra.Command{
 			inputMode = render.NoInputMode
 		}
 
-		valuesData, err := values.LoadValuesFile(valuesFilePath)
-		failIfError(err, `Failed to load values file "%s"`, valuesFilePath)
+		err = checkStdinValues(valuesFilesPaths, noInput)
+		failIfError(err, `Failed to load values files`)
+
+		valuesData, err := values.LoadValuesFiles(valuesFilesPaths)
+		failIfError(err, `Failed to load values files`)
 
 		templateUrl = normalizeTemplateUrl(templateUrl)
-		err = renderTemplate(templateUrl, extraRoots, blueprintPath, outPath, inputMode, valuesData, overrides, !noOverwrites)
+		err = renderTemplate(templateUrl, extraRoots, blueprintPath, outPath, preset, inputMode, valuesData, overrides, saveValuesPath, !noOverwrites, allowHooks)
 		failIfError(err, "Failed to render template")
+
+		if afterRender {
+			err = buildArtifact(outPath)
+			failIfBuildError(err)
+		}
 	},
 }
 
+// checkStdinValues allows reading values from stdin only once and only with noinput option,
+// stdin is read to the end so neither user input nor hooks could read from it afterwards.
+func checkStdinValues(valuesFilesPaths []string, noInput bool) error {
+	stdinCount := 0
+	for _, valuesFilePath := range valuesFilesPaths {
+		if valuesFilePath == values.StdinValuesPath {
+			stdinCount++
+		}
+	}
+	if stdinCount > 1 {
+		return fmt.Errorf(`values could be read from stdin only once`)
+	}
+	if stdinCount == 1 && !noInput {
+		return fmt.Errorf(`reading values from stdin requires --%s option as stdin can't be used for user input afterwards`, NoInput)
+	}
+	return nil
+}
+
 func normalizeTemplateUrl(templateUrl string) string {
 	if strings.HasPrefix(templateUrl, "github.com") {
 		parts := strings.Split(templateUrl, "/")
@@ -102,17 +147,71 @@ func normalizeTemplateUrl(templateUrl string) string {
 	return templateUrl
 }
 
-func renderTemplate(sourceUrl string, extraRoots []string, blueprintPath string, outPath string, inputMode render.InputMode, valuesData *values.ValuesData, overrides []string, overwriteFiles bool) error {
-	template := render.Template{sourceUrl, blueprintPath, extraRoots}
-	renderedFiles, err := template.Render(inputMode, valuesData, overrides)
+func renderTemplate(sourceUrl string, extraRoots []string, blueprintPath string, outPath string, preset string, inputMode render.InputMode, valuesData []values.ValuesData, overrides []string, saveValuesPath string, overwriteFiles bool, allowHooks bool) error {
+	template := render.Template{
+		Source:        sourceUrl,
+		BlueprintPath: blueprintPath,
+		ExtraRoots:    extraRoots,
+		OutPath:       outPath,
+		Preset:        preset,
+	}
+	result, err := template.RenderResult(inputMode, valuesData, overrides)
+	if err != nil {
+		return err
+	}
+
+	err = trustHooks(&result.Hooks, inputMode, allowHooks)
+	if err != nil {
+		return err
+	}
+
+	err = render.RunHooks(result.Hooks.PreRender, outPath)
 	if err != nil {
 		return err
 	}
 
-	err = renderedFiles.WriteAll(outPath, overwriteFiles)
+	err = result.Files.WriteAll(outPath, overwriteFiles)
 	if err != nil {
 		return err
 	}
+
+	if saveValuesPath != "" {
+		err = values.SaveValuesFile(saveValuesPath, result.SavedValues())
+		if err != nil {
+			return err
+		}
+	}
+
+	err = render.RunHooks(result.Hooks.PostRender, outPath)
+	if err != nil {
+		return err
+	}
+
+	instructions := result.Instructions()
+	if instructions != "" {
+		fmt.Println(instructions)
+	}
+	return nil
+}
+
+func trustHooks(hooks *render.Hooks, inputMode render.InputMode, allowHooks bool) error {
+	if !hooks.Remote || allowHooks {
+		return nil
+	}
+	if inputMode == render.NoInputMode {
+		return fmt.Errorf(`remote template has hooks, use --%s option to run them`, AllowHooks)
+	}
+	fmt.Println("Remote template runs commands in the output folder:")
+	for _, hook := range append(hooks.PreRender, hooks.PostRender...) {
+		fmt.Printf("  %s: %s\n", hook.Dir, hook.Command)
+	}
+	trusted, err := input.Confirm("Do you trust the template and allow running these commands?", false)
+	if err != nil {
+		return err
+	}
+	if !trusted {
+		return fmt.Errorf(`hooks of remote template were not allowed`)
+	}
 	return nil
 }
 
@@ -0,0 +1,3 @@
+#!/bin/bash
+echo "Building module 1: api"
+echo "Building module 2: web"
@@ -0,0 +1 @@
+docker build -t my-service .
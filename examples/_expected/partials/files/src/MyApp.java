@@ -0,0 +1,5 @@
+/*
+ * Copyright The Author
+ */
+public class MyApp {
+}
@@ -9,6 +9,7 @@ import (
 	"gotest.tools/v3/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -16,7 +17,20 @@ var exampleTestCases = []ExampleTestCase{
 	{"simple", "json_values"},
 	{"simple", "yaml_values"},
 	{"simple", "override_values"},
+	{"simple", "preset_values"},
+	{"simple", "layered_values"},
 	{"folders", "folders"},
+	{"partials", "partials"},
+	{"delimiters", "delimiters"},
+	{"gotemplate", "gotemplate"},
+	{"repeat", "repeat"},
+	{"binary", "binary"},
+	{"ignore", "ignore"},
+	{"roots", "roots"},
+	{"roots", "extra_roots"},
+	{"extends/service", "extends"},
+	{"extends/worker", "extends_worker"},
+	{"fullstack", "fullstack"},
 }
 
 type ExampleTestCase struct {
@@ -53,15 +67,15 @@ func Test_Examples(t *testing.T) {
 
 		expectedCasePath := filepath.Join(expectedPath, testcase.Expected)
 
-		valuesPath := filepath.Join(expectedCasePath, `values.yaml`)
-		if !render.Exists(valuesPath) {
-			valuesPath = filepath.Join(expectedCasePath, `values.json`)
-		}
-		var valuesData *values.ValuesData = nil
-		if render.Exists(valuesPath) {
-			valuesData, err = values.LoadValuesFile(valuesPath)
-			if err != nil {
-				t.Fatalf(`failed to load values data "%s": %s`, valuesPath, err.Error())
+		var valuesData []values.ValuesData = nil
+		for _, valuesFile := range []string{`values.json`, `values.yaml`, `values.toml`, `values.env`} {
+			valuesPath := filepath.Join(expectedCasePath, valuesFile)
+			if render.Exists(valuesPath) {
+				data, err := values.LoadValuesFile(valuesPath)
+				if err != nil {
+					t.Fatalf(`failed to load values data "%s": %s`, valuesPath, err.Error())
+				}
+				valuesData = append(valuesData, *data)
 			}
 		}
 
@@ -82,6 +96,33 @@ func Test_Examples(t *testing.T) {
 			file.Close()
 		}
 
+		var extraRoots []string = nil
+		rootsPath := filepath.Join(expectedCasePath, `values.roots`)
+		if render.Exists(rootsPath) {
+			extraRoots = []string{}
+			file, err := os.Open(rootsPath)
+			if err != nil {
+				t.Fatalf(`failed to read file "%s": %s`, rootsPath, err.Error())
+			}
+
+			scanner := bufio.NewScanner(file)
+			scanner.Split(bufio.ScanLines)
+			for scanner.Scan() {
+				extraRoots = append(extraRoots, fmt.Sprintf(`file:///%s`, filepath.Join(examplesPath, scanner.Text())))
+			}
+			file.Close()
+		}
+
+		preset := ""
+		presetPath := filepath.Join(expectedCasePath, `values.preset`)
+		if render.Exists(presetPath) {
+			data, err := os.ReadFile(presetPath)
+			if err != nil {
+				t.Fatalf(`failed to read file "%s": %s`, presetPath, err.Error())
+			}
+			preset = strings.TrimSpace(string(data))
+		}
+
 		outPath, err := os.MkdirTemp(actualPath, testcase.Expected)
 		if err != nil {
 			t.Fatalf(`failed to get temp folder path: %s`, err.Error())
@@ -90,7 +131,7 @@ func Test_Examples(t *testing.T) {
 		if err != nil {
 			t.Fatalf(`failed to change mode for folder "%s": %s`, outPath, err.Error())
 		}
-		err = RenderExampleTemplate(templatePath, valuesData, overrides, outPath)
+		err = RenderExampleTemplate(templatePath, extraRoots, preset, valuesData, overrides, outPath)
 		if err != nil {
 			t.Fatalf(`failed to render template: %s`, err.Error())
 		}
@@ -101,9 +142,15 @@ func Test_Examples(t *testing.T) {
 	}
 }
 
-func RenderExampleTemplate(templatePath string, valuesData *values.ValuesData, overrides []string, outPath string) error {
+func RenderExampleTemplate(templatePath string, extraRoots []string, preset string, valuesData []values.ValuesData, overrides []string, outPath string) error {
 	templateUrl := fmt.Sprintf(`file:///%s`, templatePath)
-	template := render.Template{templateUrl, "rendr.yaml", nil}
+	template := render.Template{
+		Source:        templateUrl,
+		BlueprintPath: "rendr.yaml",
+		ExtraRoots:    extraRoots,
+		OutPath:       outPath,
+		Preset:        preset,
+	}
 	renderedFiles, err := template.Render(render.NoInputMode, valuesData, overrides)
 	if err != nil {
 		return err
@@ -0,0 +1 @@
+infra
@@ -0,0 +1,4 @@
+#!/bin/bash
+{{- range $index, $module := .modules.value}}
+echo "Building module {{add1 $index}}: {{$module}}"
+{{- end}}
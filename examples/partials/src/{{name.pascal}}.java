@@ -0,0 +1,3 @@
+{{> license_header}}
+public class {{name.pascal}} {
+}
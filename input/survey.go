@@ -71,3 +71,24 @@ func getArray(arg blueprint.NamedArg) (values.ArgValue, error) {
 	err := survey.AskOne(prompt, &value)
 	return value, err
 }
+
+func Confirm(message string, defaultValue bool) (bool, error) {
+	value := false
+	prompt := &survey.Confirm{
+		Message: message,
+		Default: defaultValue,
+	}
+	err := survey.AskOne(prompt, &value)
+	return value, err
+}
+
+func Select(message string, options []string, defaultValue string) (string, error) {
+	value := ""
+	prompt := &survey.Select{
+		Message: message,
+		Options: options,
+		Default: defaultValue,
+	}
+	err := survey.AskOne(prompt, &value)
+	return value, err
+}
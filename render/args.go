@@ -1,21 +1,51 @@
 package render
 
 import (
+	"fmt"
 	"github.com/specgen-io/rendr/blueprint"
 	"github.com/specgen-io/rendr/input"
 	"github.com/specgen-io/rendr/values"
+	"os"
 )
 
-func (t Template) GetArgsValues(args blueprint.Args, inputMode InputMode, valuesData *values.ValuesData, overridesKeysValues []string) (values.ArgsValues, error) {
+const NoPreset = "none"
+
+// GetArgsValues collects arguments values, later sources override earlier ones:
+// preset, values files, environment variables, overrides and finally user input for missing values.
+func (t Template) GetArgsValues(args blueprint.Args, presets blueprint.Presets, engine values.Engine, inputMode InputMode, valuesData []values.ValuesData, builtins values.ArgsValues, overridesKeysValues []string) (values.ArgsValues, error) {
+	argsValues, err := t.getRawArgsValues(args, presets, engine, inputMode, valuesData, builtins, overridesKeysValues)
+	if err != nil {
+		return nil, err
+	}
+	return values.EnrichValues(args, argsValues), nil
+}
+
+func (t Template) getRawArgsValues(args blueprint.Args, presets blueprint.Presets, engine values.Engine, inputMode InputMode, valuesData []values.ValuesData, builtins values.ArgsValues, overridesKeysValues []string) (values.ArgsValues, error) {
 	var err error = nil
 
-	argsValues, err := values.ReadValuesData(args, valuesData)
+	argsValues, err := t.getPresetValues(args, presets, inputMode)
 	if err != nil {
 		return nil, err
 	}
 
-	if argsValues == nil {
-		argsValues = values.ArgsValues{}
+	dataValues, err := values.ReadValuesDataList(args, valuesData)
+	if err != nil {
+		return nil, err
+	}
+	if dataValues != nil {
+		argsValues, err = values.OverrideValues(args, argsValues, dataValues)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	envValues, err := values.EnvValues(args, os.LookupEnv)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to read values from environment: %s`, err.Error())
+	}
+	argsValues, err = values.OverrideValues(args, argsValues, envValues)
+	if err != nil {
+		return nil, err
 	}
 
 	if overridesKeysValues != nil {
@@ -29,16 +59,48 @@ func (t Template) GetArgsValues(args blueprint.Args, inputMode InputMode, values
 		}
 	}
 
+	if t.mappedValues != nil {
+		mappedValues, err := values.ParseTypedValues(args, t.mappedValues)
+		if err != nil {
+			return nil, err
+		}
+		argsValues, err = values.OverrideValues(args, argsValues, mappedValues)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	argsInput := input.Survey
 	if inputMode == NoInputMode {
 		argsInput = input.NoInput
 	}
-	argsValues, err = values.GetValues(args, inputMode == ForceInputMode, inputMode == NoInputMode, argsValues, argsInput)
+	return values.GetValuesWithBuiltins(args, inputMode == ForceInputMode, inputMode == NoInputMode, argsValues, builtins, engine, argsInput)
+}
+
+// getPresetValues returns values of the preset selected with Template.Preset.
+// When no preset is selected and input is allowed, the user is asked to pick one of the blueprint presets,
+// sub-templates never ask for the preset.
+func (t Template) getPresetValues(args blueprint.Args, presets blueprint.Presets, inputMode InputMode) (values.ArgsValues, error) {
+	presetName := t.Preset
+	if presetName == "" && inputMode != NoInputMode && !t.subTemplate && len(presets) > 0 {
+		selected, err := input.Select("Preset:", append([]string{NoPreset}, presets.Names()...), NoPreset)
+		if err != nil {
+			return nil, err
+		}
+		if selected != NoPreset {
+			presetName = selected
+		}
+	}
+	if presetName == "" {
+		return values.ArgsValues{}, nil
+	}
+	preset := presets.Find(presetName)
+	if preset == nil {
+		return nil, fmt.Errorf(`preset "%s" is not declared in the blueprint`, presetName)
+	}
+	argsValues, err := values.ReadValuesMap(args, preset.Values)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf(`preset "%s": %s`, presetName, err.Error())
 	}
-
-	argsValues = values.EnrichValues(args, argsValues)
-
 	return argsValues, nil
 }
@@ -0,0 +1,44 @@
+package render
+
+import (
+	"github.com/specgen-io/rendr/blueprint"
+	"github.com/specgen-io/rendr/values"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+var presetsArgs = blueprint.Args{
+	blueprint.NamedStringArg("name", "", false, "", nil, nil),
+	blueprint.NamedStringArg("build", "", false, "", nil, nil),
+}
+
+var presets = blueprint.Presets{
+	{Name: "gradle", Values: map[string]interface{}{"build": "gradle"}},
+}
+
+func Test_GetPresetValues(t *testing.T) {
+	template := Template{Preset: "gradle"}
+	argsValues, err := template.getPresetValues(presetsArgs, presets, NoInputMode)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, argsValues, values.ArgsValues{"build": "gradle"})
+}
+
+func Test_GetPresetValuesNoPreset(t *testing.T) {
+	template := Template{}
+	argsValues, err := template.getPresetValues(presetsArgs, presets, NoInputMode)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, argsValues, values.ArgsValues{})
+}
+
+func Test_GetPresetValuesUnknown(t *testing.T) {
+	template := Template{Preset: "maven"}
+	_, err := template.getPresetValues(presetsArgs, presets, NoInputMode)
+	assert.Error(t, err, `preset "maven" is not declared in the blueprint`)
+}
+
+func Test_GetPresetValuesSubTemplate(t *testing.T) {
+	template := Template{subTemplate: true}
+	argsValues, err := template.getPresetValues(presetsArgs, presets, RegularInputMode)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, argsValues, values.ArgsValues{})
+}
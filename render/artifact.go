@@ -1,6 +1,8 @@
 package render
 
 import (
+	"fmt"
+	"github.com/specgen-io/rendr/console"
 	"gopkg.in/specgen-io/yaml.v3"
 	"io/ioutil"
 	"path"
@@ -25,3 +27,13 @@ func GetArtifact(outPath string) (*Artifact, error) {
 	}
 	return &artifact, nil
 }
+
+// Build runs the build command of the artifact in the folder of the rendered project.
+// Error returned from the failed command is *exec.ExitError keeping the exit code of the command.
+func (artifact *Artifact) Build(outPath string) error {
+	if artifact.BuildCommand == "" {
+		return fmt.Errorf(`build command is not set in "%s"`, path.Join(outPath, ArtifactFilename))
+	}
+	console.Verbose("Building in %s: %s", outPath, artifact.BuildCommand)
+	return runCommand(artifact.BuildCommand, outPath)
+}
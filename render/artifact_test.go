@@ -0,0 +1,53 @@
+package render
+
+import (
+	"errors"
+	"gotest.tools/v3/assert"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func writeArtifact(t *testing.T, content string) string {
+	outPath := t.TempDir()
+	err := os.WriteFile(filepath.Join(outPath, ArtifactFilename), []byte(content), 0644)
+	assert.NilError(t, err)
+	return outPath
+}
+
+func Test_ArtifactBuild(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("build commands in test use sh syntax")
+	}
+	outPath := writeArtifact(t, "build: echo built > built.txt\n")
+	artifact, err := GetArtifact(outPath)
+	assert.NilError(t, err)
+	err = artifact.Build(outPath)
+	assert.NilError(t, err)
+	data, err := os.ReadFile(filepath.Join(outPath, "built.txt"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), "built\n")
+}
+
+func Test_ArtifactBuildExitCode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("build commands in test use sh syntax")
+	}
+	outPath := writeArtifact(t, "build: exit 5\n")
+	artifact, err := GetArtifact(outPath)
+	assert.NilError(t, err)
+	err = artifact.Build(outPath)
+	var exitErr *exec.ExitError
+	assert.Assert(t, errors.As(err, &exitErr))
+	assert.Equal(t, exitErr.ExitCode(), 5)
+}
+
+func Test_ArtifactBuildNoCommand(t *testing.T) {
+	outPath := writeArtifact(t, "{}\n")
+	artifact, err := GetArtifact(outPath)
+	assert.NilError(t, err)
+	err = artifact.Build(outPath)
+	assert.ErrorContains(t, err, "build command is not set")
+}
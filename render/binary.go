@@ -0,0 +1,34 @@
+package render
+
+import (
+	"bytes"
+	"github.com/specgen-io/rendr/blueprint"
+	"unicode/utf8"
+)
+
+const binaryDetectionBlockSize = 8000
+
+// isBinaryFile checks if the template file should be copied byte-for-byte.
+// Globs from binary and text blueprint sections override automatic detection, binary globs win if both match.
+func isBinaryFile(blueprint *blueprint.Blueprint, filePath string, data []byte) bool {
+	if blueprint.BinaryPaths.MatchesPatterns(filePath) {
+		return true
+	}
+	if blueprint.TextPaths.MatchesPatterns(filePath) {
+		return false
+	}
+	return isBinary(data)
+}
+
+// isBinary detects binary data by NUL bytes or invalid UTF-8 in the first block of data.
+func isBinary(data []byte) bool {
+	block := data
+	if len(block) > binaryDetectionBlockSize {
+		block = block[:binaryDetectionBlockSize]
+		// the last character could be cut in the middle by the end of the block
+		for cut := 0; cut < utf8.UTFMax-1 && !utf8.Valid(block); cut++ {
+			block = block[:len(block)-1]
+		}
+	}
+	return bytes.IndexByte(block, 0) != -1 || !utf8.Valid(block)
+}
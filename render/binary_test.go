@@ -0,0 +1,40 @@
+package render
+
+import (
+	"github.com/specgen-io/rendr/blueprint"
+	"strings"
+	"testing"
+)
+
+var casesIsBinaryFile = []IsBinaryFileTestCase{
+	{"text", "README.md", []byte("# {{name.value}}\n"), nil, nil, false},
+	{"utf-8 text", "README.md", []byte("# Привет, {{name.value}}\n"), nil, nil, false},
+	{"empty", "empty.txt", []byte{}, nil, nil, false},
+	{"nul byte", "logo.png", []byte{0x89, 'P', 'N', 'G', 0x0d, 0x0a, 0x1a, 0x0a, 0x00, 0x00}, nil, nil, true},
+	{"invalid utf-8", "latin1.txt", []byte{'c', 'a', 'f', 0xe9}, nil, nil, true},
+	{"invalid utf-8 after first block", "big.txt", []byte(strings.Repeat("a", binaryDetectionBlockSize) + "\xe9"), nil, nil, false},
+	{"character cut by first block", "big.txt", []byte(strings.Repeat("a", binaryDetectionBlockSize-1) + "й"), nil, nil, false},
+	{"binary glob", "data/raw.txt", []byte("{{name.value}}"), blueprint.PathArray{"data/**"}, nil, true},
+	{"text glob", "latin1.txt", []byte{'c', 'a', 'f', 0xe9}, nil, blueprint.PathArray{"*.txt"}, false},
+	{"binary glob wins", "data/raw.txt", []byte("{{name.value}}"), blueprint.PathArray{"data/**"}, blueprint.PathArray{"*.txt"}, true},
+}
+
+func Test_IsBinaryFile(t *testing.T) {
+	for _, testcase := range casesIsBinaryFile {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		templateBlueprint := &blueprint.Blueprint{BinaryPaths: testcase.BinaryPaths, TextPaths: testcase.TextPaths}
+		actual := isBinaryFile(templateBlueprint, testcase.Path, testcase.Data)
+		if actual != testcase.Expected {
+			t.Errorf("Failed, file %s binary detection\nexpected: %v\nactual:   %v", testcase.Path, testcase.Expected, actual)
+		}
+	}
+}
+
+type IsBinaryFileTestCase struct {
+	Name        string
+	Path        string
+	Data        []byte
+	BinaryPaths blueprint.PathArray
+	TextPaths   blueprint.PathArray
+	Expected    bool
+}
@@ -0,0 +1,96 @@
+package render
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/config"
+	"github.com/specgen-io/rendr/blueprint"
+	"github.com/specgen-io/rendr/values"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (t *Template) GetBuiltinValues(templateBlueprint *blueprint.Blueprint) values.ArgsValues {
+	now := time.Now()
+	userName, userEmail := getGitUser(t.OutPath)
+	builtin := map[string]interface{}{
+		"date": now.Format("2006-01-02"),
+		"year": strconv.Itoa(now.Year()),
+		"template": map[string]interface{}{
+			"name":   templateBlueprint.Name,
+			"title":  templateBlueprint.Title,
+			"source": t.Source,
+			"commit": getCommit(t.Source),
+		},
+		"output": getOutputName(t.OutPath),
+		"git": map[string]interface{}{
+			"user": map[string]interface{}{
+				"name":  userName,
+				"email": userEmail,
+			},
+		},
+	}
+	return values.ArgsValues{blueprint.BuiltinArgName: builtin}
+}
+
+func getOutputName(outPath string) string {
+	if outPath == "" {
+		outPath = "."
+	}
+	absolutePath, err := filepath.Abs(outPath)
+	if err != nil {
+		return filepath.Base(outPath)
+	}
+	return filepath.Base(absolutePath)
+}
+
+func getCommit(sourceUrl string) string {
+	source, _ := splitSource(sourceUrl)
+	repository, found := repositories[source]
+	if !found {
+		if !strings.HasPrefix(source, "file:///") {
+			return ""
+		}
+		localRepository, err := openLocalRepository(strings.TrimPrefix(source, "file:///"))
+		if err != nil {
+			return ""
+		}
+		repository = localRepository
+	}
+	head, err := repository.Head()
+	if err != nil {
+		return ""
+	}
+	return head.Hash().String()
+}
+
+func getGitUser(outPath string) (string, string) {
+	name := ""
+	email := ""
+	globalConfig, err := config.LoadConfig(config.GlobalScope)
+	if err == nil {
+		name = globalConfig.User.Name
+		email = globalConfig.User.Email
+	}
+	if outPath == "" {
+		outPath = "."
+	}
+	repository, err := openLocalRepository(outPath)
+	if err == nil {
+		localConfig, err := repository.Config()
+		if err == nil {
+			if localConfig.User.Name != "" {
+				name = localConfig.User.Name
+			}
+			if localConfig.User.Email != "" {
+				email = localConfig.User.Email
+			}
+		}
+	}
+	return name, email
+}
+
+func openLocalRepository(path string) (*git.Repository, error) {
+	return git.PlainOpenWithOptions(path, &git.PlainOpenOptions{DetectDotGit: true})
+}
@@ -0,0 +1,21 @@
+package render
+
+import (
+	"github.com/specgen-io/rendr/blueprint"
+	"gotest.tools/v3/assert"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func Test_GetBuiltinValues(t *testing.T) {
+	template := Template{Source: "file:///some/template", OutPath: "/some/path/my-service"}
+	builtinValues := template.GetBuiltinValues(&blueprint.Blueprint{Name: "example", Title: "Example template"})
+	builtin := builtinValues[blueprint.BuiltinArgName].(map[string]interface{})
+	assert.Equal(t, builtin["year"], strconv.Itoa(time.Now().Year()))
+	assert.Equal(t, builtin["output"], "my-service")
+	templateValues := builtin["template"].(map[string]interface{})
+	assert.Equal(t, templateValues["name"], "example")
+	assert.Equal(t, templateValues["title"], "Example template")
+	assert.Equal(t, templateValues["source"], "file:///some/template")
+}
@@ -0,0 +1,80 @@
+package render
+
+import (
+	"fmt"
+	"github.com/specgen-io/rendr/blueprint"
+	"path"
+	"strings"
+)
+
+// loadExtendedBlueprint loads the blueprint and merges it into the blueprints it extends.
+// Roots of extended blueprints keep the source of their template.
+func loadExtendedBlueprint(sourceUrl string, blueprintPath string, visited map[string]bool) (*blueprint.Blueprint, error) {
+	visited[sourceUrl+"/"+blueprintPath] = true
+	result, err := loadBlueprint(sourceUrl, blueprintPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Roots) == 0 {
+		result.Roots = []blueprint.Root{{Path: "."}}
+	}
+	if result.Extends == "" {
+		return result, nil
+	}
+	parentUrl, parentBlueprintPath, err := resolveTemplateReference(sourceUrl, result.Extends)
+	if err != nil {
+		return nil, err
+	}
+	if visited[parentUrl+"/"+parentBlueprintPath] {
+		return nil, fmt.Errorf(`blueprint extends "%s" in cycle`, result.Extends)
+	}
+	parent, err := loadExtendedBlueprint(parentUrl, parentBlueprintPath, visited)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to load extended blueprint "%s": %s`, result.Extends, err.Error())
+	}
+	for i := range parent.Roots {
+		if parent.Roots[i].Source == "" {
+			parent.Roots[i].Source = parentUrl
+		}
+	}
+	return blueprint.Extend(parent, result), nil
+}
+
+// resolveTemplateReference returns url of the template referenced from another template and path to its blueprint.
+// Template is referenced by url, like https://github.com/org/templates.git/base#v1.0.0,
+// or by path relative to the referencing template, like ../base.
+// Blueprint file could be set explicitly, like ../base/service.yaml, otherwise rendr.yaml is used.
+func resolveTemplateReference(sourceUrl string, reference string) (string, string, error) {
+	blueprintPath := blueprint.DefaultBlueprintFilename
+	ref := ""
+	if index := strings.LastIndex(reference, "#"); index != -1 && !strings.HasPrefix(reference, "file:///") {
+		ref = reference[index:]
+		reference = reference[:index]
+	}
+	if strings.HasSuffix(reference, ".yaml") || strings.HasSuffix(reference, ".yml") {
+		blueprintPath = reference[strings.LastIndex(reference, "/")+1:]
+		reference = strings.TrimSuffix(strings.TrimSuffix(reference, blueprintPath), "/")
+		if reference == "" {
+			reference = "."
+		}
+	}
+	if strings.Contains(reference, "://") {
+		return reference + ref, blueprintPath, nil
+	}
+	if strings.HasPrefix(sourceUrl, "file:///") {
+		return "file:///" + path.Join(strings.TrimPrefix(sourceUrl, "file:///"), reference), blueprintPath, nil
+	}
+	source, sourcePath := splitSource(sourceUrl)
+	referencePath := path.Join(sourcePath, reference)
+	if referencePath == ".." || strings.HasPrefix(referencePath, "../") {
+		return "", "", fmt.Errorf(`template "%s" points outside of the repository`, reference)
+	}
+	repoUrl, sourceRef, _ := strings.Cut(source, "#")
+	if ref == "" && sourceRef != "" {
+		ref = "#" + sourceRef
+	}
+	if referencePath == "." {
+		return repoUrl + ref, blueprintPath, nil
+	}
+	return repoUrl + "/" + referencePath + ref, blueprintPath, nil
+}
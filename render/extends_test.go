@@ -0,0 +1,45 @@
+package render
+
+import (
+	"testing"
+)
+
+var casesResolveTemplateReference = []ResolveTemplateReferenceTestCase{
+	{"local path", "file:///templates/service", "../base", "file:///templates/base", "rendr.yaml"},
+	{"local blueprint file", "file:///templates/service", "../base/base.yaml", "file:///templates/base", "base.yaml"},
+	{"path in repository", "https://github.com/org/templates.git/service", "../base", "https://github.com/org/templates.git/base", "rendr.yaml"},
+	{"repository root", "https://github.com/org/templates.git/service", "..", "https://github.com/org/templates.git", "rendr.yaml"},
+	{"path in repository keeps ref", "https://github.com/org/templates.git/service#v1", "../base", "https://github.com/org/templates.git/base#v1", "rendr.yaml"},
+	{"url", "file:///templates/service", "https://github.com/org/base.git", "https://github.com/org/base.git", "rendr.yaml"},
+	{"url with blueprint file", "file:///templates/service", "https://github.com/org/templates.git/base/base.yaml", "https://github.com/org/templates.git/base", "base.yaml"},
+	{"blueprint file in the same template", "file:///templates/service", "other.yaml", "file:///templates/service", "other.yaml"},
+	{"url with ref", "file:///templates/service", "https://github.com/org/templates.git/base#v1.0.0", "https://github.com/org/templates.git/base#v1.0.0", "rendr.yaml"},
+}
+
+func Test_ResolveTemplateReference(t *testing.T) {
+	for _, testcase := range casesResolveTemplateReference {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		url, blueprintPath, err := resolveTemplateReference(testcase.SourceUrl, testcase.Extends)
+		if err != nil {
+			t.Fatalf("Failed, unexpected error: %s", err.Error())
+		}
+		if url != testcase.ExpectedUrl || blueprintPath != testcase.ExpectedBlueprintPath {
+			t.Errorf("Failed, resolved extends does not match\nexpected: %s %s\nactual:   %s %s", testcase.ExpectedUrl, testcase.ExpectedBlueprintPath, url, blueprintPath)
+		}
+	}
+}
+
+func Test_ResolveTemplateReferenceOutsideOfRepository(t *testing.T) {
+	_, _, err := resolveTemplateReference("https://github.com/org/templates.git/service", "../../base")
+	if err == nil {
+		t.Errorf("Failed, error expected")
+	}
+}
+
+type ResolveTemplateReferenceTestCase struct {
+	Name                  string
+	SourceUrl             string
+	Extends               string
+	ExpectedUrl           string
+	ExpectedBlueprintPath string
+}
@@ -2,6 +2,7 @@ package render
 
 import (
 	"github.com/specgen-io/rendr/console"
+	"io/fs"
 	"io/ioutil"
 	"os"
 	"path"
@@ -9,11 +10,14 @@ import (
 	"runtime"
 )
 
+// DefaultFileMode is used for files when source file mode is not available.
+const DefaultFileMode fs.FileMode = 0644
+
 type File struct {
-	Path       string
-	Content    string
-	Executable bool
-	Template   bool
+	Path     string
+	Content  []byte
+	Mode     fs.FileMode
+	Template bool
 }
 
 type Files []File
@@ -31,23 +35,20 @@ func (file *File) Write(outPath string, overwrite bool) error {
 	fullPath := path.Join(outPath, file.Path)
 	if overwrite || !Exists(fullPath) {
 		console.Verbose("Writing: %s", fullPath)
-		data := []byte(file.Content)
+		data := file.Content
 
 		dir := filepath.Dir(fullPath)
 		_ = os.MkdirAll(dir, os.ModePerm)
 
-		err := ioutil.WriteFile(fullPath, data, 0644)
+		err := ioutil.WriteFile(fullPath, data, file.Mode)
 		if err != nil {
 			return err
 		}
 
-		if file.Executable && runtime.GOOS != "windows" {
-			err = os.Chmod(fullPath, 0700)
-			if err != nil {
-				return err
-			}
+		if runtime.GOOS != "windows" {
+			// mode of WriteFile is limited by umask and is not applied to existing files
+			return os.Chmod(fullPath, file.Mode)
 		}
-		return ioutil.WriteFile(fullPath, data, 0644)
 	} else {
 		console.Verbose("Skipping: %s", fullPath)
 	}
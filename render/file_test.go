@@ -0,0 +1,25 @@
+package render
+
+import (
+	"gotest.tools/v3/assert"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func Test_FileWriteMode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not supported on windows")
+	}
+	outPath := t.TempDir()
+	for _, mode := range []fs.FileMode{0644, 0755, 0750, 0600} {
+		file := File{"script.sh", []byte("echo hello"), mode, false}
+		err := file.Write(outPath, true)
+		assert.NilError(t, err)
+		info, err := os.Stat(filepath.Join(outPath, file.Path))
+		assert.NilError(t, err)
+		assert.Equal(t, info.Mode().Perm(), mode)
+	}
+}
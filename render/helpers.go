@@ -0,0 +1,9 @@
+package render
+
+import "github.com/specgen-io/rendr/values"
+
+// RegisterHelper makes helper available in all templates as Mustache lambda: {{#name}}text{{/name}}.
+// The text is rendered first and then passed to the helper.
+func RegisterHelper(name string, helper values.Helper) {
+	values.RegisterHelper(name, helper)
+}
@@ -0,0 +1,110 @@
+package render
+
+import (
+	"fmt"
+	"github.com/specgen-io/rendr/blueprint"
+	"github.com/specgen-io/rendr/console"
+	"github.com/specgen-io/rendr/values"
+	"os"
+	"os/exec"
+	"path"
+	"runtime"
+)
+
+// Hook is the rendered command run in the folder of the output.
+type Hook struct {
+	Command string
+	Dir     string
+}
+
+// Hooks are rendered commands of the template and its sub-templates.
+// Remote is set when any of the hooks comes from the remote template and should be trusted before running.
+type Hooks struct {
+	PreRender  []Hook
+	PostRender []Hook
+	Remote     bool
+}
+
+func (hooks *Hooks) Empty() bool {
+	return len(hooks.PreRender) == 0 && len(hooks.PostRender) == 0
+}
+
+func (hooks *Hooks) append(other Hooks) *Hooks {
+	return &Hooks{
+		append(append([]Hook{}, hooks.PreRender...), other.PreRender...),
+		append(append([]Hook{}, hooks.PostRender...), other.PostRender...),
+		hooks.Remote || other.Remote,
+	}
+}
+
+func (hooks *Hooks) inFolder(folder string) {
+	for i := range hooks.PreRender {
+		hooks.PreRender[i].Dir = path.Join(folder, hooks.PreRender[i].Dir)
+	}
+	for i := range hooks.PostRender {
+		hooks.PostRender[i].Dir = path.Join(folder, hooks.PostRender[i].Dir)
+	}
+}
+
+// renderHooks renders hooks commands with arguments values, values are quoted for shell whenever needed.
+func renderHooks(templateBlueprint *blueprint.Blueprint, argsValues values.ArgsValues) (*Hooks, error) {
+	var engine values.Engine = values.Mustache{Escape: blueprint.EscapeShell}
+	if templateBlueprint.Engine == blueprint.EngineGoTemplate {
+		engine = values.GoTemplate{Escape: blueprint.EscapeShell}
+	}
+	preRender, err := renderCommands(engine, templateBlueprint.Hooks.PreRender, argsValues)
+	if err != nil {
+		return nil, err
+	}
+	postRender, err := renderCommands(engine, templateBlueprint.Hooks.PostRender, argsValues)
+	if err != nil {
+		return nil, err
+	}
+	hooks := &Hooks{preRender, postRender, false}
+	hooks.Remote = templateBlueprint.Remote && !hooks.Empty()
+	return hooks, nil
+}
+
+func renderCommands(engine values.Engine, commands []string, argsValues values.ArgsValues) ([]Hook, error) {
+	result := []Hook{}
+	for _, command := range commands {
+		rendered, err := engine.Render(command, argsValues)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to render hook "%s": %s`, command, err.Error())
+		}
+		result = append(result, Hook{Command: rendered, Dir: "."})
+	}
+	return result, nil
+}
+
+// RunHooks runs hooks commands one by one in the output folder, output of commands is streamed to the console.
+// Running stops on the first command that exits with non-zero code.
+func RunHooks(hooks []Hook, outPath string) error {
+	for _, hook := range hooks {
+		dir := path.Join(outPath, hook.Dir)
+		err := os.MkdirAll(dir, os.ModePerm)
+		if err != nil {
+			return err
+		}
+		console.Verbose("Running hook in %s: %s", dir, hook.Command)
+		err = runCommand(hook.Command, dir)
+		if err != nil {
+			return fmt.Errorf(`hook "%s" failed: %s`, hook.Command, err.Error())
+		}
+	}
+	return nil
+}
+
+func runCommand(command string, dir string) error {
+	var cmd *exec.Cmd
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", command)
+	} else {
+		cmd = exec.Command("sh", "-c", command)
+	}
+	cmd.Dir = dir
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
@@ -0,0 +1,91 @@
+package render
+
+import (
+	"github.com/specgen-io/rendr/blueprint"
+	"github.com/specgen-io/rendr/values"
+	"gotest.tools/v3/assert"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func Test_RenderHooks(t *testing.T) {
+	templateBlueprint := &blueprint.Blueprint{
+		Hooks: blueprint.Hooks{
+			PreRender:  []string{"echo {{name.value}}"},
+			PostRender: []string{"go mod init {{module.value}}"},
+		},
+		Remote: true,
+	}
+	argsValues := values.ArgsValues{
+		"name":   map[string]interface{}{"value": "my service"},
+		"module": map[string]interface{}{"value": "github.com/org/service"},
+	}
+	hooks, err := renderHooks(templateBlueprint, argsValues)
+	assert.NilError(t, err)
+	expected := &Hooks{
+		PreRender:  []Hook{{"echo 'my service'", "."}},
+		PostRender: []Hook{{"go mod init github.com/org/service", "."}},
+		Remote:     true,
+	}
+	assert.DeepEqual(t, hooks, expected)
+}
+
+func Test_RenderHooksOptionalFlag(t *testing.T) {
+	templateBlueprint := &blueprint.Blueprint{
+		Hooks:  blueprint.Hooks{PostRender: []string{"mvn{{#profile.value}} -P{{profile.value}}{{/profile.value}}"}},
+		Remote: false,
+	}
+	hooks, err := renderHooks(templateBlueprint, values.ArgsValues{"profile": map[string]interface{}{"value": ""}})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, hooks.PostRender, []Hook{{"mvn", "."}})
+
+	hooks, err = renderHooks(templateBlueprint, values.ArgsValues{"profile": map[string]interface{}{"value": "dev"}})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, hooks.PostRender, []Hook{{"mvn -Pdev", "."}})
+}
+
+func Test_RenderHooksEmptyNotRemote(t *testing.T) {
+	hooks, err := renderHooks(&blueprint.Blueprint{Remote: true}, values.ArgsValues{})
+	assert.NilError(t, err)
+	assert.Equal(t, hooks.Remote, false)
+}
+
+func Test_RenderHooksLocalTemplate(t *testing.T) {
+	templateBlueprint := &blueprint.Blueprint{
+		Hooks:  blueprint.Hooks{PostRender: []string{"make"}},
+		Remote: false,
+	}
+	hooks, err := renderHooks(templateBlueprint, values.ArgsValues{})
+	assert.NilError(t, err)
+	expected := &Hooks{
+		PreRender:  []Hook{},
+		PostRender: []Hook{{"make", "."}},
+		Remote:     false,
+	}
+	assert.DeepEqual(t, hooks, expected)
+}
+
+func Test_RunHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hooks commands in test use sh syntax")
+	}
+	outPath := t.TempDir()
+	err := RunHooks([]Hook{{"echo hello > hello.txt", "sub"}}, outPath)
+	assert.NilError(t, err)
+	data, err := os.ReadFile(filepath.Join(outPath, "sub", "hello.txt"))
+	assert.NilError(t, err)
+	assert.Equal(t, string(data), "hello\n")
+}
+
+func Test_RunHooksFailure(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hooks commands in test use sh syntax")
+	}
+	outPath := t.TempDir()
+	err := RunHooks([]Hook{{"exit 3", "."}, {"echo never > never.txt", "."}}, outPath)
+	assert.Error(t, err, `hook "exit 3" failed: exit status 3`)
+	_, err = os.Stat(filepath.Join(outPath, "never.txt"))
+	assert.Assert(t, os.IsNotExist(err))
+}
@@ -0,0 +1,53 @@
+package render
+
+import (
+	"fmt"
+	"github.com/specgen-io/rendr/blueprint"
+	"github.com/specgen-io/rendr/values"
+	"strings"
+)
+
+func renderMessage(templateBlueprint *blueprint.Blueprint, argsValues values.ArgsValues) (string, []string, error) {
+	engine := values.NewRawEngine(templateBlueprint.Engine)
+	message, err := engine.Render(templateBlueprint.Message, argsValues)
+	if err != nil {
+		return "", nil, err
+	}
+	nextSteps := []string{}
+	for _, step := range templateBlueprint.NextSteps {
+		renderedStep, err := engine.Render(step, argsValues)
+		if err != nil {
+			return "", nil, err
+		}
+		nextSteps = append(nextSteps, renderedStep)
+	}
+	return strings.TrimSpace(message), nextSteps, nil
+}
+
+func joinMessages(message string, other string) string {
+	if message == "" {
+		return other
+	}
+	if other == "" {
+		return message
+	}
+	return message + "\n" + other
+}
+
+// Instructions returns the message and numbered next steps to show to the user after files are written.
+func (result *Result) Instructions() string {
+	lines := []string{}
+	if result.Message != "" {
+		lines = append(lines, result.Message)
+	}
+	if len(result.NextSteps) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "Next steps:")
+		for index, step := range result.NextSteps {
+			lines = append(lines, fmt.Sprintf("  %d. %s", index+1, step))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
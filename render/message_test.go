@@ -0,0 +1,35 @@
+package render
+
+import (
+	"github.com/specgen-io/rendr/blueprint"
+	"github.com/specgen-io/rendr/values"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+func Test_RenderMessage(t *testing.T) {
+	templateBlueprint := &blueprint.Blueprint{
+		Message:   "Service {{name.value}} & friends was created\n",
+		NextSteps: []string{"cd {{rendr.output}}", "./gradlew run"},
+	}
+	argsValues := values.ArgsValues{
+		"name":  map[string]interface{}{"value": "billing"},
+		"rendr": map[string]interface{}{"output": "billing-service"},
+	}
+	message, nextSteps, err := renderMessage(templateBlueprint, argsValues)
+	assert.NilError(t, err)
+	assert.Equal(t, message, "Service billing & friends was created")
+	assert.DeepEqual(t, nextSteps, []string{"cd billing-service", "./gradlew run"})
+}
+
+func Test_ResultInstructions(t *testing.T) {
+	result := &Result{Message: "Service was created", NextSteps: []string{"cd billing", "./gradlew run"}}
+	expected := `Service was created
+
+Next steps:
+  1. cd billing
+  2. ./gradlew run`
+	assert.Equal(t, result.Instructions(), expected)
+	assert.Equal(t, (&Result{}).Instructions(), "")
+	assert.Equal(t, (&Result{NextSteps: []string{"make"}}).Instructions(), "Next steps:\n  1. make")
+}
@@ -0,0 +1,63 @@
+package render
+
+import (
+	"fmt"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/specgen-io/rendr/blueprint"
+	"path"
+	"strings"
+)
+
+// partialsProvider looks up partials in the folders in order, the first found partial wins.
+type partialsProvider struct {
+	folders []partialsFolder
+}
+
+type partialsFolder struct {
+	filesystem billy.Filesystem
+	path       string
+}
+
+func (p *partialsProvider) Get(name string) (string, error) {
+	paths := []string{}
+	for _, folder := range p.folders {
+		partialPath := folder.find(name)
+		if partialPath != "" {
+			data, err := util.ReadFile(folder.filesystem, partialPath)
+			if err != nil {
+				return "", err
+			}
+			return string(data), nil
+		}
+		paths = append(paths, folder.path)
+	}
+	return "", fmt.Errorf(`partial "%s" was not found in "%s"`, name, strings.Join(paths, `", "`))
+}
+
+func (f *partialsFolder) find(name string) string {
+	partialPath := path.Join(f.path, name)
+	if _, err := f.filesystem.Stat(partialPath); err == nil {
+		return partialPath
+	}
+	files, _ := f.filesystem.ReadDir(f.path)
+	for _, file := range files {
+		if !file.IsDir() && strings.TrimSuffix(file.Name(), path.Ext(file.Name())) == name {
+			return path.Join(f.path, file.Name())
+		}
+	}
+	return ""
+}
+
+func getPartials(sources []blueprint.PartialsSource) (*partialsProvider, error) {
+	folders := []partialsFolder{}
+	for _, partialsSource := range sources {
+		source, sourcePath := splitSource(partialsSource.Source)
+		filesystem, err := getFilesystem(source)
+		if err != nil {
+			return nil, err
+		}
+		folders = append(folders, partialsFolder{filesystem, path.Join(sourcePath, partialsSource.Path)})
+	}
+	return &partialsProvider{folders}, nil
+}
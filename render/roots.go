@@ -0,0 +1,115 @@
+package render
+
+import (
+	"fmt"
+	"github.com/specgen-io/rendr/blueprint"
+	"github.com/specgen-io/rendr/values"
+	"strings"
+)
+
+// loadRoots returns template roots, extra root with its own blueprint file gets the blueprint loaded.
+func (t *Template) loadRoots(templateBlueprint *blueprint.Blueprint) ([]Root, error) {
+	roots := t.GetRoots(templateBlueprint)
+	for i := len(templateBlueprint.Roots); i < len(roots); i++ {
+		exists, err := blueprintExists(roots[i].Url, blueprint.DefaultBlueprintFilename)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		rootBlueprint, err := loadBlueprint(roots[i].Url, blueprint.DefaultBlueprintFilename)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to load blueprint of root "%s": %s`, roots[i].Url, err.Error())
+		}
+		err = validateRootBlueprint(rootBlueprint)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to load blueprint of root "%s": %s`, roots[i].Url, err.Error())
+		}
+		roots[i].Blueprint = rootBlueprint
+	}
+	return roots, nil
+}
+
+// validateRootBlueprint checks the extra root blueprint, which is used only for arguments and files rules.
+// Blueprint fields that have effect only for the template itself are reported as errors instead of being ignored.
+func validateRootBlueprint(rootBlueprint *blueprint.Blueprint) error {
+	unsupported := []string{}
+	if rootBlueprint.Extends != "" {
+		unsupported = append(unsupported, "extends")
+	}
+	if len(rootBlueprint.Roots) > 0 {
+		unsupported = append(unsupported, "roots")
+	}
+	if rootBlueprint.Partials != blueprint.DefaultPartials {
+		unsupported = append(unsupported, "partials")
+	}
+	if len(rootBlueprint.Presets) > 0 {
+		unsupported = append(unsupported, "presets")
+	}
+	if len(rootBlueprint.Templates) > 0 {
+		unsupported = append(unsupported, "templates")
+	}
+	if len(rootBlueprint.Hooks.PreRender) > 0 || len(rootBlueprint.Hooks.PostRender) > 0 {
+		unsupported = append(unsupported, "hooks")
+	}
+	if rootBlueprint.Message != "" || len(rootBlueprint.NextSteps) > 0 {
+		unsupported = append(unsupported, "message")
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf(`extra root blueprint doesn't support: %s`, strings.Join(unsupported, ", "))
+	}
+	return rootBlueprint.Args.Validate(values.HelperNames()...)
+}
+
+// mergeRootsArgs adds arguments of extra roots blueprints to the template arguments.
+// Arguments of the blueprint with namespace are grouped under the namespace name, otherwise they are merged as is.
+func mergeRootsArgs(templateBlueprint *blueprint.Blueprint, roots []Root) (blueprint.Args, error) {
+	result := append(blueprint.Args{}, templateBlueprint.Args...)
+	for _, root := range roots {
+		if root.Blueprint == nil {
+			continue
+		}
+		rootArgs := root.Blueprint.Args
+		if root.Blueprint.Namespace != "" {
+			rootArgs = blueprint.Args{blueprint.NamedGroupArg(root.Blueprint.Namespace, root.Blueprint.Title, false, "", rootArgs)}
+		}
+		for _, arg := range rootArgs {
+			if arg.Name == blueprint.BuiltinArgName {
+				return nil, fmt.Errorf(`argument name "%s" of root "%s" is reserved for built-in values`, arg.Name, root.Url)
+			}
+			if result.FindByName(arg.Name) != nil {
+				return nil, fmt.Errorf(`argument "%s" of root "%s" clashes with argument declared before, use namespace in the root blueprint to avoid clashes`, arg.Name, root.Url)
+			}
+			result = append(result, arg)
+		}
+	}
+	return result, nil
+}
+
+func renameFiles(files []File, rename map[string]string) {
+	for source, target := range rename {
+		for i := range files {
+			path := files[i].Path
+			if strings.HasPrefix(path, source) {
+				files[i].Path = strings.Replace(path, source, target, 1)
+			}
+		}
+	}
+}
+
+// overrideFiles keeps only the last of the files with the same path, so files of the extending template
+// and later roots override files of the extended template and earlier roots.
+func overrideFiles(files []File) []File {
+	indexes := map[string]int{}
+	result := []File{}
+	for _, file := range files {
+		if index, found := indexes[file.Path]; found {
+			result[index] = file
+		} else {
+			indexes[file.Path] = len(result)
+			result = append(result, file)
+		}
+	}
+	return result
+}
@@ -0,0 +1,96 @@
+package render
+
+import (
+	"fmt"
+	"github.com/specgen-io/rendr/blueprint"
+	"gotest.tools/v3/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_MergeRootsArgs(t *testing.T) {
+	templateBlueprint := &blueprint.Blueprint{Args: blueprint.Args{
+		blueprint.NamedStringArg("name", "", false, "", nil, nil),
+	}}
+	roots := []Root{
+		{Url: "file:///template"},
+		{Url: "file:///addons/ci", Blueprint: &blueprint.Blueprint{Args: blueprint.Args{
+			blueprint.NamedStringArg("runner", "", false, "", nil, nil),
+		}}},
+		{Url: "file:///addons/observability", Blueprint: &blueprint.Blueprint{Namespace: "observability", Title: "Observability", Args: blueprint.Args{
+			blueprint.NamedStringArg("name", "", false, "", nil, nil),
+		}}},
+	}
+	args, err := mergeRootsArgs(templateBlueprint, roots)
+	assert.NilError(t, err)
+	expected := blueprint.Args{
+		blueprint.NamedStringArg("name", "", false, "", nil, nil),
+		blueprint.NamedStringArg("runner", "", false, "", nil, nil),
+		blueprint.NamedGroupArg("observability", "Observability", false, "", blueprint.Args{
+			blueprint.NamedStringArg("name", "", false, "", nil, nil),
+		}),
+	}
+	assert.DeepEqual(t, args, expected)
+}
+
+func Test_MergeRootsArgsClash(t *testing.T) {
+	templateBlueprint := &blueprint.Blueprint{Args: blueprint.Args{
+		blueprint.NamedStringArg("name", "", false, "", nil, nil),
+	}}
+	roots := []Root{
+		{Url: "file:///addons/ci", Blueprint: &blueprint.Blueprint{Args: blueprint.Args{
+			blueprint.NamedStringArg("name", "", false, "", nil, nil),
+		}}},
+	}
+	_, err := mergeRootsArgs(templateBlueprint, roots)
+	assert.Error(t, err, `argument "name" of root "file:///addons/ci" clashes with argument declared before, use namespace in the root blueprint to avoid clashes`)
+}
+
+func Test_LoadRootsCustomBlueprintPath(t *testing.T) {
+	rootPath := t.TempDir()
+	rootBlueprint := "rendr: 0\nname: ci\nargs:\n  runner:\n    type: string\n"
+	err := os.WriteFile(filepath.Join(rootPath, blueprint.DefaultBlueprintFilename), []byte(rootBlueprint), 0644)
+	assert.NilError(t, err)
+	template := Template{
+		BlueprintPath: "custom.yaml",
+		ExtraRoots:    []string{fmt.Sprintf(`file:///%s`, filepath.ToSlash(rootPath))},
+	}
+	roots, err := template.loadRoots(&blueprint.Blueprint{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(roots), 1)
+	assert.Assert(t, roots[0].Blueprint != nil)
+	assert.Equal(t, roots[0].Blueprint.Args[0].Name, "runner")
+}
+
+func Test_ValidateRootBlueprint(t *testing.T) {
+	rootBlueprint := &blueprint.Blueprint{
+		Partials: blueprint.DefaultPartials,
+		Args:     blueprint.Args{blueprint.NamedStringArg("runner", "", false, "", nil, nil)},
+	}
+	assert.NilError(t, validateRootBlueprint(rootBlueprint))
+}
+
+func Test_ValidateRootBlueprintUnsupported(t *testing.T) {
+	rootBlueprint := &blueprint.Blueprint{
+		Extends:  "../base",
+		Partials: blueprint.DefaultPartials,
+		Presets:  blueprint.Presets{{Name: "gradle", Values: map[string]interface{}{}}},
+		Hooks:    blueprint.Hooks{PostRender: []string{"make"}},
+	}
+	err := validateRootBlueprint(rootBlueprint)
+	assert.Error(t, err, `extra root blueprint doesn't support: extends, presets, hooks`)
+}
+
+func Test_OverrideFiles(t *testing.T) {
+	files := []File{
+		{"README.md", []byte("base"), 0644, false},
+		{"Dockerfile", []byte("base"), 0644, false},
+		{"README.md", []byte("service"), 0644, false},
+	}
+	expected := []File{
+		{"README.md", []byte("service"), 0644, false},
+		{"Dockerfile", []byte("base"), 0644, false},
+	}
+	assert.DeepEqual(t, overrideFiles(files), expected)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/go-git/go-billy/v5/osfs"
 	"github.com/go-git/go-billy/v5/util"
 	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/storage/memory"
 	"github.com/specgen-io/rendr/blueprint"
 	"github.com/specgen-io/rendr/values"
@@ -19,6 +20,14 @@ type Template struct {
 	Source        string
 	BlueprintPath string
 	ExtraRoots    []string
+	OutPath       string
+	Preset        string
+	// subTemplate is set for templates rendered as sub-templates, their values are mapped from the parent template
+	// so the preset is not requested from the user.
+	subTemplate bool
+	// mappedValues are values of sub-template arguments mapped from the parent template by arguments paths,
+	// they override all other values sources.
+	mappedValues map[string]interface{}
 }
 
 type InputMode string
@@ -29,63 +38,165 @@ const (
 	ForceInputMode   InputMode = "force"
 )
 
-func (t *Template) Render(inputMode InputMode, valuesData *values.ValuesData, overridesKeysValues []string) (Files, error) {
+func (t *Template) Render(inputMode InputMode, valuesData []values.ValuesData, overridesKeysValues []string) (Files, error) {
+	result, err := t.RenderResult(inputMode, valuesData, overridesKeysValues)
+	if err != nil {
+		return nil, err
+	}
+	return result.Files, nil
+}
+
+// Result is the rendered template: files to write, hooks to run around writing them
+// and message with next steps to show to the user after files are written.
+// Values are raw arguments values the template was rendered with, Templates are results of sub-templates by their keys,
+// all values could be saved with SavedValues for re-running the template.
+type Result struct {
+	Files     Files
+	Hooks     Hooks
+	Message   string
+	NextSteps []string
+	Args      blueprint.Args
+	Values    values.ArgsValues
+	Templates map[string]*Result
+}
+
+// SavedValues returns values of the template in the shape of values data.
+// Values of sub-templates are nested under rendr.templates by the sub-template key and are read back with values.TemplateValuesData.
+func (result *Result) SavedValues() map[string]interface{} {
+	data := values.ValuesDataMap(result.Args, result.Values)
+	templates := map[string]interface{}{}
+	for key, templateResult := range result.Templates {
+		templateValues := templateResult.SavedValues()
+		if len(templateValues) > 0 {
+			templates[key] = templateValues
+		}
+	}
+	if len(templates) > 0 {
+		data[blueprint.BuiltinArgName] = map[string]interface{}{values.TemplatesValuesKey: templates}
+	}
+	return data
+}
+
+func (t *Template) RenderResult(inputMode InputMode, valuesData []values.ValuesData, overridesKeysValues []string) (*Result, error) {
 	blueprint, err := t.LoadBlueprint()
 	if err != nil {
 		return nil, fmt.Errorf("failed to load template blueprint: %s", err.Error())
 	}
 
-	argsValues, err := t.GetArgsValues(blueprint.Args, inputMode, valuesData, overridesKeysValues)
+	roots, err := t.loadRoots(blueprint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load template roots: %s", err.Error())
+	}
+	args, err := mergeRootsArgs(blueprint, roots)
+	if err != nil {
+		return nil, fmt.Errorf("failed to merge roots arguments: %s", err.Error())
+	}
+
+	builtins := t.GetBuiltinValues(blueprint)
+	rawArgsValues, err := t.getRawArgsValues(args, blueprint.Presets, values.NewEngine(blueprint.Engine), inputMode, valuesData, builtins, overridesKeysValues)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get args values: %s", err.Error())
 	}
+	argsValues := values.EnrichValues(args, rawArgsValues)
+	for name, value := range builtins {
+		argsValues[name] = value
+	}
+
+	partials, err := getPartials(blueprint.PartialsSources)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load template partials: %s", err.Error())
+	}
 
 	files := []File{}
 
-	roots := t.GetRoots(blueprint)
 	for _, root := range roots {
-		rootFiles, err := renderRoot(root, blueprint, argsValues)
+		included, err := values.IsConditionTrue(values.NewEngine(blueprint.Engine), root.Condition, argsValues)
 		if err != nil {
-			return nil, fmt.Errorf(`failed to render template root "%s": %s`, root, err.Error())
+			return nil, fmt.Errorf(`failed to evaluate condition of template root "%s": %s`, root.Url, err.Error())
+		}
+		if !included {
+			continue
+		}
+		rootBlueprint := blueprint
+		if root.Blueprint != nil {
+			rootBlueprint = root.Blueprint
+		}
+		rootFiles, err := renderRoot(root.Url, rootBlueprint, &templateEngines{rootBlueprint, partials}, argsValues)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to render template root "%s": %s`, root.Url, err.Error())
+		}
+		if root.Blueprint != nil {
+			renameFiles(rootFiles, root.Blueprint.Rename)
+		}
+		for i := range rootFiles {
+			rootFiles[i].Path = path.Join(root.Target, rootFiles[i].Path)
 		}
 		files = append(files, rootFiles...)
 	}
 
-	for source, target := range blueprint.Rename {
-		for i := range files {
-			path := files[i].Path
-			if strings.HasPrefix(path, source) {
-				files[i].Path = strings.Replace(path, source, target, 1)
-			}
-		}
+	renameFiles(files, blueprint.Rename)
+
+	hooks, err := renderHooks(blueprint, argsValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render hooks: %s", err.Error())
 	}
 
-	return files, nil
+	subTemplatesResult, err := t.renderSubTemplates(blueprint, inputMode, valuesData, argsValues)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, subTemplatesResult.Files...)
+	files = overrideFiles(files)
+	hooks = hooks.append(subTemplatesResult.Hooks)
+
+	message, nextSteps, err := renderMessage(blueprint, argsValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render message: %s", err.Error())
+	}
+	message = joinMessages(message, subTemplatesResult.Message)
+	nextSteps = append(nextSteps, subTemplatesResult.NextSteps...)
+
+	return &Result{files, *hooks, message, nextSteps, args, rawArgsValues, subTemplatesResult.Templates}, nil
 }
 
 type Root struct {
-	Source string
-	Path   string
+	Url       string
+	Condition string
+	Target    string
+	Blueprint *blueprint.Blueprint
 }
 
-func (t *Template) GetRoots(blueprint *blueprint.Blueprint) []string {
-	result := []string{}
-	for _, rootPath := range blueprint.Roots {
+func (t *Template) GetRoots(blueprint *blueprint.Blueprint) []Root {
+	result := []Root{}
+	for _, root := range blueprint.Roots {
 		rootFullPath := t.Source
-		if rootPath != "." {
-			rootFullPath = fmt.Sprintf("%s/%s", rootFullPath, rootPath)
+		if root.Source != "" {
+			rootFullPath = root.Source
+		}
+		if root.Path != "." {
+			rootFullPath = fmt.Sprintf("%s/%s", rootFullPath, root.Path)
 		}
-		result = append(result, rootFullPath)
+		result = append(result, Root{Url: rootFullPath, Condition: root.Condition, Target: root.Target})
 	}
-	if t.ExtraRoots != nil {
-		result = append(result, t.ExtraRoots...)
+	for _, extraRoot := range t.ExtraRoots {
+		result = append(result, parseExtraRoot(extraRoot))
 	}
 	return result
 }
 
+// parseExtraRoot parses extra root in format "url" or "url=target" where target is the folder in the output.
+func parseExtraRoot(extraRoot string) Root {
+	index := strings.LastIndex(extraRoot, "=")
+	if index == -1 {
+		return Root{Url: extraRoot}
+	}
+	return Root{Url: extraRoot[:index], Target: extraRoot[index+1:]}
+}
+
 func renderRoot(
 	rootUrl string,
 	blueprint *blueprint.Blueprint,
+	engines *templateEngines,
 	argsValues values.ArgsValues) ([]File, error) {
 
 	source, rootPath := splitSource(rootUrl)
@@ -94,12 +205,12 @@ func renderRoot(
 		return nil, err
 	}
 
-	templateFiles, err := getFiles(filesystem, rootPath, blueprint.IgnorePaths, blueprint.ExecutablePaths, blueprint.StaticPaths)
+	templateFiles, err := getFiles(filesystem, rootPath, blueprint)
 	if err != nil {
 		return nil, err
 	}
 
-	renderedFiles, err := renderFiles(templateFiles, argsValues)
+	renderedFiles, err := renderFiles(templateFiles, engines, argsValues)
 	if err != nil {
 		return nil, err
 	}
@@ -107,23 +218,47 @@ func renderRoot(
 	return renderedFiles, nil
 }
 
+// splitSource splits source url into repository url and path inside of the repository.
+// Git reference set after "#" in the end of the url is kept in the repository url: https://github.com/org/repo.git/path#v1.0.0
 func splitSource(sourceUrl string) (string, string) {
-	if strings.HasPrefix(sourceUrl, "file:///") || strings.HasSuffix(sourceUrl, ".git") {
+	if strings.HasPrefix(sourceUrl, "file:///") {
 		return sourceUrl, ""
 	}
+	ref := ""
+	if index := strings.LastIndex(sourceUrl, "#"); index != -1 {
+		ref = sourceUrl[index:]
+		sourceUrl = sourceUrl[:index]
+	}
+	if strings.HasSuffix(sourceUrl, ".git") {
+		return sourceUrl + ref, ""
+	}
 	parts := strings.Split(sourceUrl, ".git/")
 	path := parts[1]
 	source := sourceUrl[:len(sourceUrl)-1-len(path)]
-	return source, path
+	return source + ref, path
 }
 
+// LoadBlueprint loads the template blueprint merged with blueprints it extends.
+// Arguments are validated after merging, so child arguments could reference arguments of the parent.
 func (t *Template) LoadBlueprint() (*blueprint.Blueprint, error) {
-	source, sourcePath := splitSource(t.Source)
+	result, err := loadExtendedBlueprint(t.Source, t.BlueprintPath, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	err = result.Args.Validate(values.HelperNames()...)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func loadBlueprint(sourceUrl string, blueprintPath string) (*blueprint.Blueprint, error) {
+	source, sourcePath := splitSource(sourceUrl)
 	filesystem, err := getFilesystem(source)
 	if err != nil {
 		return nil, err
 	}
-	blueprintFullpath := path.Join(sourcePath, t.BlueprintPath)
+	blueprintFullpath := path.Join(sourcePath, blueprintPath)
 	data, err := util.ReadFile(filesystem, blueprintFullpath)
 	if err != nil {
 		return nil, err
@@ -132,17 +267,74 @@ func (t *Template) LoadBlueprint() (*blueprint.Blueprint, error) {
 	if err != nil {
 		return nil, err
 	}
-	result.IgnorePaths = append(result.IgnorePaths, t.BlueprintPath)
-	if result == nil || len(result.Roots) == 0 {
-		result.Roots = []string{"."}
+	if result.Partials == "" {
+		result.Partials = blueprint.DefaultPartials
+	}
+	result.PartialsSources = []blueprint.PartialsSource{{Source: sourceUrl, Path: result.Partials}}
+	partialsPath := strings.TrimSuffix(result.Partials, "/") + "/"
+	if result.PrefixPaths {
+		result.IgnorePaths = append(result.IgnorePaths, blueprintPath, partialsPath, blueprint.IgnoreFilename)
+	} else {
+		result.IgnorePaths = append(result.IgnorePaths, "/"+blueprintPath, "/"+partialsPath, "/"+blueprint.IgnoreFilename)
 	}
+	ignoreFilePaths, err := readIgnoreFile(filesystem, path.Join(sourcePath, blueprint.IgnoreFilename))
+	if err != nil {
+		return nil, err
+	}
+	result.IgnoreFilePaths = ignoreFilePaths
+	result.Remote = !strings.HasPrefix(source, "file:///")
 	if result.Rename == nil {
 		result.Rename = map[string]string{}
 	}
+	for i := range result.Templates {
+		subTemplate := &result.Templates[i]
+		subTemplate.Url, subTemplate.BlueprintPath, err = resolveTemplateReference(sourceUrl, subTemplate.Source)
+		if err != nil {
+			return nil, err
+		}
+		if subTemplatePath, inner := innerTemplatePath(subTemplate.Source); inner {
+			if result.PrefixPaths {
+				result.IgnorePaths = append(result.IgnorePaths, subTemplatePath+"/")
+			} else {
+				result.IgnorePaths = append(result.IgnorePaths, "/"+subTemplatePath+"/")
+			}
+		}
+	}
+	return result, nil
+}
+
+func blueprintExists(sourceUrl string, blueprintPath string) (bool, error) {
+	source, sourcePath := splitSource(sourceUrl)
+	filesystem, err := getFilesystem(source)
+	if err != nil {
+		return false, err
+	}
+	_, err = filesystem.Stat(path.Join(sourcePath, blueprintPath))
+	return err == nil, nil
+}
+
+// readIgnoreFile reads gitignore style patterns from the file, empty lines and comments starting with "#" are skipped.
+func readIgnoreFile(filesystem billy.Filesystem, ignoreFilePath string) (blueprint.PathArray, error) {
+	if _, err := filesystem.Stat(ignoreFilePath); err != nil {
+		return nil, nil
+	}
+	data, err := util.ReadFile(filesystem, ignoreFilePath)
+	if err != nil {
+		return nil, err
+	}
+	result := blueprint.PathArray{}
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		result = append(result, line)
+	}
 	return result, nil
 }
 
 var filesystems = make(map[string]billy.Filesystem)
+var repositories = make(map[string]*git.Repository)
 
 func getFilesystem(url string) (billy.Filesystem, error) {
 	if filesystem, found := filesystems[url]; found {
@@ -154,21 +346,44 @@ func getFilesystem(url string) (billy.Filesystem, error) {
 		filesystems[url] = filesystem
 		return filesystem, nil
 	} else {
+		repoUrl, ref, _ := strings.Cut(url, "#")
 		filesystem := memfs.New()
-		_, err := git.Clone(memory.NewStorage(), filesystem, &git.CloneOptions{URL: url})
+		repository, err := git.Clone(memory.NewStorage(), filesystem, &git.CloneOptions{URL: repoUrl})
 		if err != nil {
 			return nil, err
 		}
+		if ref != "" {
+			err = checkoutRef(repository, ref)
+			if err != nil {
+				return nil, fmt.Errorf(`failed to checkout "%s": %s`, ref, err.Error())
+			}
+		}
 		filesystems[url] = filesystem
+		repositories[url] = repository
 		return filesystem, nil
 	}
 }
 
-func getFiles(filesystem billy.Filesystem, rootFullPath string, excludePrefixes blueprint.PathArray, executablePaths blueprint.PathArray, staticPaths blueprint.PathArray) ([]File, error) {
+func checkoutRef(repository *git.Repository, ref string) error {
+	hash, err := repository.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		hash, err = repository.ResolveRevision(plumbing.Revision("origin/" + ref))
+		if err != nil {
+			return err
+		}
+	}
+	worktree, err := repository.Worktree()
+	if err != nil {
+		return err
+	}
+	return worktree.Checkout(&git.CheckoutOptions{Hash: *hash})
+}
+
+func getFiles(filesystem billy.Filesystem, rootFullPath string, blueprint *blueprint.Blueprint) ([]File, error) {
 	result := []File{}
 	err := Walk(filesystem, rootFullPath, func(itempath string, info fs.FileInfo, err error) error {
 		filepath := strings.TrimPrefix(strings.TrimPrefix(itempath, rootFullPath), "/")
-		if excludePrefixes.Matches(filepath) {
+		if blueprint.IsIgnored(filepath) {
 			return nil
 		}
 		if !info.IsDir() {
@@ -176,10 +391,18 @@ func getFiles(filesystem billy.Filesystem, rootFullPath string, excludePrefixes
 			if err != nil {
 				return nil
 			}
-			executable := executablePaths.Contains(filepath)
-			static := staticPaths.Matches(filepath)
-			template := !executable && !static
-			file := File{filepath, string(data), executable, template}
+			mode := info.Mode().Perm()
+			if mode == 0 {
+				mode = DefaultFileMode
+			}
+			executableMode, executable := blueprint.GetExecutableMode(filepath)
+			if executable {
+				mode = executableMode
+			}
+			static := blueprint.IsStatic(filepath)
+			binary := isBinaryFile(blueprint, filepath, data)
+			template := !executable && !static && !binary
+			file := File{filepath, data, mode, template}
 			result = append(result, file)
 		}
 		return nil
@@ -190,55 +413,103 @@ func getFiles(filesystem billy.Filesystem, rootFullPath string, excludePrefixes
 	return result, nil
 }
 
-func renderFile(sourceFile *File, argsValues values.ArgsValues) (*File, error) {
-	renderedPath, err := renderPath(sourceFile.Path, argsValues)
+type templateEngines struct {
+	blueprint *blueprint.Blueprint
+	partials  *partialsProvider
+}
+
+func (e *templateEngines) content(templatePath string) values.Engine {
+	settings := e.blueprint.GetPathSettings(templatePath)
+	if settings.Engine == blueprint.EngineGoTemplate {
+		return values.GoTemplate{Escape: settings.Escape, Delimiters: settings.Delimiters}
+	}
+	return values.Mustache{Partials: e.partials, Escape: settings.Escape, Delimiters: settings.Delimiters}
+}
+
+func (e *templateEngines) path(templatePath string) values.Engine {
+	settings := e.blueprint.GetPathSettings(templatePath)
+	return values.NewEngine(settings.Engine)
+}
+
+func renderFile(sourceFile *File, engines *templateEngines, argsValues values.ArgsValues) ([]File, error) {
+	renderedPaths, err := renderPath(sourceFile.Path, engines.path(sourceFile.Path), argsValues)
 	if err != nil {
 		return nil, err
 	}
-	if renderedPath == nil {
-		return nil, nil
-	}
 
-	content := sourceFile.Content
-	if sourceFile.Template {
-		content, err = values.Render(content, argsValues)
-		if err != nil {
-			return nil, err
+	result := []File{}
+	for _, renderedPath := range renderedPaths {
+		content := sourceFile.Content
+		if sourceFile.Template {
+			renderedContent, err := engines.content(sourceFile.Path).Render(string(content), renderedPath.ArgsValues)
+			if err != nil {
+				return nil, err
+			}
+			content = []byte(renderedContent)
 		}
+		result = append(result, File{renderedPath.Path, content, sourceFile.Mode, false})
 	}
-
-	return &File{*renderedPath, content, sourceFile.Executable, false}, nil
+	return result, nil
 }
 
-func renderFiles(templateFiles []File, argsValues values.ArgsValues) ([]File, error) {
+func renderFiles(templateFiles []File, engines *templateEngines, argsValues values.ArgsValues) ([]File, error) {
 	result := []File{}
 	for _, templateFile := range templateFiles {
-		renderedFile, err := renderFile(&templateFile, argsValues)
+		renderedFiles, err := renderFile(&templateFile, engines, argsValues)
 		if err != nil {
 			return nil, fmt.Errorf(`template "%s" returned error: %s`, templateFile.Path, err.Error())
 		}
-		if renderedFile != nil {
-			result = append(result, *renderedFile)
-		}
+		result = append(result, renderedFiles...)
 	}
 	return result, nil
 }
 
-func renderPath(templatePath string, argsValues values.ArgsValues) (*string, error) {
-	parts := strings.Split(templatePath, "/")
-	resultParts := []string{}
-	for _, part := range parts {
-		resultPart, err := values.RenderShort(part, argsValues)
+type renderedPath struct {
+	Path       string
+	ArgsValues values.ArgsValues
+}
+
+// renderPath renders template path into the list of paths with values to render the file content with.
+// Excluded path is rendered into empty list.
+// Repeat syntax {{*services}} renders the rest of the path for each element of the array with the element pushed on top of values.
+func renderPath(templatePath string, engine values.Engine, argsValues values.ArgsValues) ([]renderedPath, error) {
+	return renderPathParts(strings.Split(templatePath, "/"), []string{}, engine, argsValues)
+}
+
+func renderPathParts(parts []string, resultParts []string, engine values.Engine, argsValues values.ArgsValues) ([]renderedPath, error) {
+	if len(parts) == 0 {
+		return []renderedPath{{strings.Join(resultParts, "/"), argsValues}}, nil
+	}
+	part := parts[0]
+	if strings.HasPrefix(part, "{{*") {
+		end := strings.Index(part, "}}")
+		if end == -1 {
+			return nil, fmt.Errorf(`repeat "%s" is not closed`, part)
+		}
+		elementsValues, err := values.RepeatValues(strings.TrimSpace(part[3:end]), argsValues)
 		if err != nil {
 			return nil, err
 		}
-		if resultPart == nil {
-			return nil, nil
-		}
-		if *resultPart != "" {
-			resultParts = append(resultParts, *resultPart)
+		restParts := append([]string{part[end+2:]}, parts[1:]...)
+		result := []renderedPath{}
+		for _, elementValues := range elementsValues {
+			elementPaths, err := renderPathParts(restParts, resultParts, engine, elementValues)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, elementPaths...)
 		}
+		return result, nil
+	}
+	resultPart, err := engine.RenderShort(part, argsValues)
+	if err != nil {
+		return nil, err
+	}
+	if resultPart == nil {
+		return nil, nil
+	}
+	if *resultPart != "" {
+		resultParts = append(append([]string{}, resultParts...), *resultPart)
 	}
-	result := strings.Join(resultParts, "/")
-	return &result, nil
+	return renderPathParts(parts[1:], resultParts, engine, argsValues)
 }
@@ -46,20 +46,48 @@ var renderPathTestCases = []RenderPathTestCase{
 		},
 		blueprint.StrPtr("some/path/item"),
 	},
+	{
+		"condition expression in path",
+		"some/{{?build.value == 'gradle' and docker.value}}path/item",
+		values.ArgsValues{
+			"build":  map[string]interface{}{"value": "gradle"},
+			"docker": map[string]interface{}{"value": true},
+		},
+		blueprint.StrPtr("some/path/item"),
+	},
+	{
+		"condition expression in path excluded",
+		"some/{{?build.value == 'gradle' and docker.value}}/item",
+		values.ArgsValues{
+			"build":  map[string]interface{}{"value": "gradle"},
+			"docker": map[string]interface{}{"value": false},
+		},
+		nil,
+	},
+	{
+		"package argument in path",
+		"src/{{package.dot_to_slash}}/{{name.pascal}}.java",
+		values.ArgsValues{
+			"package": map[string]interface{}{"dot_to_slash": "com/acme/app"},
+			"name":    map[string]interface{}{"pascal": "MyApp"},
+		},
+		blueprint.StrPtr("src/com/acme/app/MyApp.java"),
+	},
 }
 
 func Test_RenderPath(t *testing.T) {
 	for _, testcase := range renderPathTestCases {
 		t.Logf(`Running test case: %s`, testcase.Name)
-		renderedPath, err := renderPath(testcase.TemplatePath, testcase.ArgsValues)
+		renderedPaths, err := renderPath(testcase.TemplatePath, values.Mustache{}, testcase.ArgsValues)
 		assert.Equal(t, err, nil)
 		expected := "nil"
 		actual := "nil"
 		if testcase.Expected != nil {
 			expected = *testcase.Expected
 		}
-		if renderedPath != nil {
-			actual = *renderedPath
+		if len(renderedPaths) > 0 {
+			assert.Equal(t, len(renderedPaths), 1)
+			actual = renderedPaths[0].Path
 		}
 		if actual != expected {
 			t.Errorf("Failed, rendered path does not match\nexpected: %s\nactual:   %s", expected, actual)
@@ -73,3 +101,65 @@ type RenderPathTestCase struct {
 	ArgsValues   values.ArgsValues
 	Expected     *string
 }
+
+var renderPathRepeatTestCases = []RenderPathRepeatTestCase{
+	{
+		"repeat string array",
+		"services/{{*services}}{{kebab}}/README.md",
+		values.ArgsValues{
+			"services": map[string]interface{}{"value": []string{"Billing", "UserAccounts"}},
+		},
+		[]string{"services/billing/README.md", "services/user-accounts/README.md"},
+	},
+	{
+		"nested repeat",
+		"{{*modules}}{{value}}/{{*layers}}{{value}}.txt",
+		values.ArgsValues{
+			"modules": map[string]interface{}{"value": []string{"api", "web"}},
+			"layers":  map[string]interface{}{"value": []string{"a", "b"}},
+		},
+		[]string{"api/a.txt", "api/b.txt", "web/a.txt", "web/b.txt"},
+	},
+	{
+		"repeat empty array",
+		"{{*services}}{{value}}/README.md",
+		values.ArgsValues{
+			"services": map[string]interface{}{"value": []string{}},
+		},
+		[]string{},
+	},
+}
+
+func Test_RenderPath_Repeat(t *testing.T) {
+	for _, testcase := range renderPathRepeatTestCases {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		renderedPaths, err := renderPath(testcase.TemplatePath, values.Mustache{}, testcase.ArgsValues)
+		assert.Equal(t, err, nil)
+		actual := []string{}
+		for _, renderedPath := range renderedPaths {
+			actual = append(actual, renderedPath.Path)
+		}
+		assert.DeepEqual(t, actual, testcase.Expected)
+	}
+}
+
+type RenderPathRepeatTestCase struct {
+	Name         string
+	TemplatePath string
+	ArgsValues   values.ArgsValues
+	Expected     []string
+}
+
+func Test_ParseExtraRoot(t *testing.T) {
+	assert.Equal(t, parseExtraRoot("file:///home/templates/ci"), Root{Url: "file:///home/templates/ci"})
+	assert.Equal(t, parseExtraRoot("https://github.com/org/addons.git/ci=.github/"), Root{Url: "https://github.com/org/addons.git/ci", Target: ".github/"})
+}
+
+func Test_SplitSource(t *testing.T) {
+	source, path := splitSource("https://github.com/org/templates.git/base#v1")
+	assert.Equal(t, source, "https://github.com/org/templates.git#v1")
+	assert.Equal(t, path, "base")
+	source, path = splitSource("https://github.com/org/templates.git#main")
+	assert.Equal(t, source, "https://github.com/org/templates.git#main")
+	assert.Equal(t, path, "")
+}
@@ -0,0 +1,122 @@
+package render
+
+import (
+	"fmt"
+	"github.com/specgen-io/rendr/blueprint"
+	"github.com/specgen-io/rendr/values"
+	"path"
+	"regexp"
+	"strings"
+)
+
+func (t *Template) renderSubTemplates(templateBlueprint *blueprint.Blueprint, inputMode InputMode, valuesData []values.ValuesData, argsValues values.ArgsValues) (*Result, error) {
+	result := &Result{Files{}, Hooks{}, "", []string{}, nil, nil, map[string]*Result{}}
+	for _, subTemplate := range templateBlueprint.Templates {
+		included, err := values.IsConditionTrue(values.NewEngine(templateBlueprint.Engine), subTemplate.Condition, argsValues)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to evaluate condition of sub-template "%s": %s`, subTemplate.Source, err.Error())
+		}
+		if !included {
+			continue
+		}
+		key := subTemplateKey(result.Templates, subTemplate.Target)
+		subTemplateValuesData, err := values.TemplateValuesData(valuesData, key)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to read values of sub-template "%s": %s`, subTemplate.Source, err.Error())
+		}
+		subTemplateResult, err := t.renderSubTemplate(templateBlueprint, &subTemplate, inputMode, subTemplateValuesData, argsValues)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to render sub-template "%s": %s`, subTemplate.Source, err.Error())
+		}
+		result.Templates[key] = subTemplateResult
+		result.Files = append(result.Files, subTemplateResult.Files...)
+		result.Hooks = *result.Hooks.append(subTemplateResult.Hooks)
+		result.Message = joinMessages(result.Message, subTemplateResult.Message)
+		result.NextSteps = append(result.NextSteps, subTemplateResult.NextSteps...)
+	}
+	return result, nil
+}
+
+func (t *Template) renderSubTemplate(templateBlueprint *blueprint.Blueprint, subTemplate *blueprint.SubTemplate, inputMode InputMode, valuesData []values.ValuesData, argsValues values.ArgsValues) (*Result, error) {
+	mappedValues, err := subTemplateValues(values.NewRawEngine(templateBlueprint.Engine), subTemplate.Values, argsValues)
+	if err != nil {
+		return nil, err
+	}
+	template := Template{
+		Source:        subTemplate.Url,
+		BlueprintPath: subTemplate.BlueprintPath,
+		OutPath:       path.Join(t.OutPath, subTemplate.Target),
+		subTemplate:   true,
+		mappedValues:  mappedValues,
+	}
+	result, err := template.RenderResult(inputMode, valuesData, nil)
+	if err != nil {
+		return nil, err
+	}
+	for i := range result.Files {
+		result.Files[i].Path = path.Join(subTemplate.Target, result.Files[i].Path)
+	}
+	result.Hooks.inFolder(subTemplate.Target)
+	return result, nil
+}
+
+// subTemplateKey returns key of the sub-template values in saved values: the target folder of the sub-template,
+// sub-templates rendered into the same folder get the number suffix: api, api#2.
+func subTemplateKey(templates map[string]*Result, target string) string {
+	key := path.Clean(target)
+	for number := 2; templates[key] != nil; number++ {
+		key = fmt.Sprintf("%s#%d", path.Clean(target), number)
+	}
+	return key
+}
+
+var singleReference = regexp.MustCompile(`^{{\s*([a-zA-Z0-9_.-]+)\s*}}$`)
+
+// subTemplateValues renders values of sub-template arguments by their paths.
+// Value that is a single reference to the parent value, like {{tags.value}}, keeps the type of the referenced value,
+// so arrays and booleans are passed as they are, other values are rendered into strings.
+func subTemplateValues(engine values.Engine, subTemplateValues map[string]string, argsValues values.ArgsValues) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	for name, template := range subTemplateValues {
+		if match := singleReference.FindStringSubmatch(strings.TrimSpace(template)); match != nil {
+			if value := typedValue(argsValues, match[1]); value != nil {
+				result[name] = value
+				continue
+			}
+		}
+		value, err := engine.Render(template, argsValues)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to render value of argument "%s": %s`, name, err.Error())
+		}
+		result[name] = value
+	}
+	return result, nil
+}
+
+// typedValue returns the string, array or boolean value referenced by the path, nil is returned for other values.
+func typedValue(argsValues values.ArgsValues, reference string) interface{} {
+	value, err := values.LookupValue(argsValues, reference)
+	if err != nil {
+		return nil
+	}
+	switch value.(type) {
+	case string, []string, bool:
+		return value
+	}
+	return nil
+}
+
+// innerTemplatePath returns the folder of the template referenced by the path inside of the template referencing it.
+func innerTemplatePath(reference string) (string, bool) {
+	if strings.Contains(reference, "://") {
+		return "", false
+	}
+	if strings.HasSuffix(reference, ".yaml") || strings.HasSuffix(reference, ".yml") {
+		reference = path.Dir(reference)
+	}
+	cleaned := path.Clean(reference)
+	if cleaned == "." || cleaned == ".." || strings.HasPrefix(cleaned, "../") || strings.HasPrefix(cleaned, "/") {
+		return "", false
+	}
+	return cleaned, true
+}
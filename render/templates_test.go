@@ -0,0 +1,110 @@
+package render
+
+import (
+	"github.com/specgen-io/rendr/blueprint"
+	"github.com/specgen-io/rendr/values"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+func Test_SubTemplateValues(t *testing.T) {
+	argsValues := values.ArgsValues{
+		"name":  map[string]interface{}{"value": "my shop", "kebab": "my-shop"},
+		"tags":  map[string]interface{}{"value": []string{"a b", "c"}, "values": []string{"a b", "c"}},
+		"debug": map[string]interface{}{"value": true, "is_true": true, "is_false": false},
+	}
+	templateValues := map[string]string{
+		"service.name": "{{name.kebab}}-api",
+		"title":        `"{{name.value}}" & Co`,
+		"labels":       "{{tags.value}}",
+		"verbose":      "{{ debug.value }}",
+	}
+	actual, err := subTemplateValues(values.NewRawEngine(blueprint.EngineMustache), templateValues, argsValues)
+	assert.NilError(t, err)
+	expected := map[string]interface{}{
+		"service.name": "my-shop-api",
+		"title":        `"my shop" & Co`,
+		"labels":       []string{"a b", "c"},
+		"verbose":      true,
+	}
+	assert.DeepEqual(t, actual, expected)
+
+	args := blueprint.Args{
+		blueprint.NamedGroupArg("service", "", false, "", blueprint.Args{
+			blueprint.NamedStringArg("name", "", false, "", nil, nil),
+		}),
+		blueprint.NamedStringArg("title", "", false, "", nil, nil),
+		blueprint.NamedArrayArg("labels", "", false, "", nil, nil),
+		blueprint.NamedBooleanArg("verbose", "", false, "", nil),
+	}
+	parsed, err := values.ParseTypedValues(args, actual)
+	assert.NilError(t, err)
+	mapped, err := values.OverrideValues(args, values.ArgsValues{}, parsed)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, mapped, values.ArgsValues{
+		"service": values.ArgsValues{"name": "my-shop-api"},
+		"title":   `"my shop" & Co`,
+		"labels":  []string{"a b", "c"},
+		"verbose": true,
+	})
+}
+
+func Test_SubTemplateKey(t *testing.T) {
+	templates := map[string]*Result{}
+	assert.Equal(t, subTemplateKey(templates, "api/"), "api")
+	templates["api"] = &Result{}
+	assert.Equal(t, subTemplateKey(templates, "api"), "api#2")
+	assert.Equal(t, subTemplateKey(templates, ""), ".")
+}
+
+func Test_ResultSavedValues(t *testing.T) {
+	result := &Result{
+		Args: blueprint.Args{
+			blueprint.NamedStringArg("name", "", false, "", nil, nil),
+		},
+		Values: values.ArgsValues{"name": "my shop"},
+		Templates: map[string]*Result{
+			"api": {
+				Args: blueprint.Args{
+					blueprint.NamedStringArg("name", "", false, "", nil, nil),
+					blueprint.NamedStringArg("port", "", false, "", nil, nil),
+				},
+				Values: values.ArgsValues{"name": "my-shop-api", "port": "8080"},
+			},
+			"web": {},
+		},
+	}
+	expected := map[string]interface{}{
+		"name": "my shop",
+		"rendr": map[string]interface{}{
+			"templates": map[string]interface{}{
+				"api": map[string]interface{}{"name": "my-shop-api", "port": "8080"},
+			},
+		},
+	}
+	assert.DeepEqual(t, result.SavedValues(), expected)
+}
+
+var casesInnerTemplatePath = []InnerTemplatePathTestCase{
+	{"folder", "api", "api", true},
+	{"folder with slash", "templates/api/", "templates/api", true},
+	{"blueprint file", "api/rendr.yaml", "api", true},
+	{"outer folder", "../api", "", false},
+	{"url", "https://github.com/org/api.git", "", false},
+}
+
+func Test_InnerTemplatePath(t *testing.T) {
+	for _, testcase := range casesInnerTemplatePath {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		actual, inner := innerTemplatePath(testcase.Reference)
+		assert.Equal(t, inner, testcase.Inner)
+		assert.Equal(t, actual, testcase.Expected)
+	}
+}
+
+type InnerTemplatePathTestCase struct {
+	Name      string
+	Reference string
+	Expected  string
+	Inner     bool
+}
@@ -0,0 +1,87 @@
+package values
+
+import (
+	"strings"
+	"unicode"
+)
+
+func caseVariants(value string) map[string]interface{} {
+	words := splitWords(value)
+	return map[string]interface{}{
+		"camel":        camelCase(words),
+		"pascal":       pascalCase(words),
+		"snake":        strings.ToLower(strings.Join(words, "_")),
+		"kebab":        strings.ToLower(strings.Join(words, "-")),
+		"upper_snake":  strings.ToUpper(strings.Join(words, "_")),
+		"lower":        strings.ToLower(value),
+		"upper":        strings.ToUpper(value),
+		"title":        titleCase(words),
+		"dot_to_slash": strings.ReplaceAll(value, ".", "/"),
+	}
+}
+
+// splitWords splits value into words on any non-alphanumeric characters and on case changes:
+// "my-app_name", "myAppName" and "MyAPPName" are all split into three words
+func splitWords(value string) []string {
+	words := []string{}
+	runes := []rune(value)
+	word := []rune{}
+	for index, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(word) > 0 {
+				words = append(words, string(word))
+				word = []rune{}
+			}
+			continue
+		}
+		if len(word) > 0 && unicode.IsUpper(r) {
+			previous := word[len(word)-1]
+			nextIsLower := index+1 < len(runes) && unicode.IsLower(runes[index+1])
+			if !unicode.IsUpper(previous) || nextIsLower {
+				words = append(words, string(word))
+				word = []rune{}
+			}
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
+
+func capitalize(word string) string {
+	runes := []rune(strings.ToLower(word))
+	if len(runes) > 0 {
+		runes[0] = unicode.ToUpper(runes[0])
+	}
+	return string(runes)
+}
+
+func camelCase(words []string) string {
+	result := []string{}
+	for index, word := range words {
+		if index == 0 {
+			result = append(result, strings.ToLower(word))
+		} else {
+			result = append(result, capitalize(word))
+		}
+	}
+	return strings.Join(result, "")
+}
+
+func pascalCase(words []string) string {
+	result := []string{}
+	for _, word := range words {
+		result = append(result, capitalize(word))
+	}
+	return strings.Join(result, "")
+}
+
+func titleCase(words []string) string {
+	result := []string{}
+	for _, word := range words {
+		result = append(result, capitalize(word))
+	}
+	return strings.Join(result, " ")
+}
@@ -0,0 +1,85 @@
+package values
+
+import (
+	"github.com/google/go-cmp/cmp"
+	"github.com/specgen-io/rendr/blueprint"
+	"sort"
+	"testing"
+)
+
+var casesCaseVariants = []CaseVariantsTestCase{
+	{
+		"kebab input",
+		"my-app-name",
+		map[string]interface{}{
+			"camel":        "myAppName",
+			"pascal":       "MyAppName",
+			"snake":        "my_app_name",
+			"kebab":        "my-app-name",
+			"upper_snake":  "MY_APP_NAME",
+			"lower":        "my-app-name",
+			"upper":        "MY-APP-NAME",
+			"title":        "My App Name",
+			"dot_to_slash": "my-app-name",
+		},
+	},
+	{
+		"camel input with acronym",
+		"myHTTPServer2",
+		map[string]interface{}{
+			"camel":        "myHttpServer2",
+			"pascal":       "MyHttpServer2",
+			"snake":        "my_http_server2",
+			"kebab":        "my-http-server2",
+			"upper_snake":  "MY_HTTP_SERVER2",
+			"lower":        "myhttpserver2",
+			"upper":        "MYHTTPSERVER2",
+			"title":        "My Http Server2",
+			"dot_to_slash": "myHTTPServer2",
+		},
+	},
+	{
+		"package input",
+		"com.acme.app",
+		map[string]interface{}{
+			"camel":        "comAcmeApp",
+			"pascal":       "ComAcmeApp",
+			"snake":        "com_acme_app",
+			"kebab":        "com-acme-app",
+			"upper_snake":  "COM_ACME_APP",
+			"lower":        "com.acme.app",
+			"upper":        "COM.ACME.APP",
+			"title":        "Com Acme App",
+			"dot_to_slash": "com/acme/app",
+		},
+	},
+}
+
+func Test_CaseVariants(t *testing.T) {
+	for _, testcase := range casesCaseVariants {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		variants := caseVariants(testcase.Value)
+		if !cmp.Equal(testcase.Expected, variants) {
+			t.Errorf("Failed, variants do not match\nexpected: %s\nactual:   %s", testcase.Expected, variants)
+		}
+	}
+}
+
+func Test_CaseVariantsNames(t *testing.T) {
+	names := []string{}
+	for name := range caseVariants("value") {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	expected := append([]string{}, blueprint.NamingVariants...)
+	sort.Strings(expected)
+	if !cmp.Equal(expected, names) {
+		t.Errorf("Failed, variants names do not match blueprint.NamingVariants\nexpected: %s\nactual:   %s", expected, names)
+	}
+}
+
+type CaseVariantsTestCase struct {
+	Name     string
+	Value    string
+	Expected map[string]interface{}
+}
@@ -1,11 +1,16 @@
 package values
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"github.com/BurntSushi/toml"
 	"github.com/specgen-io/rendr/blueprint"
 	"gopkg.in/specgen-io/yaml.v3"
+	"io"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 )
 
@@ -70,10 +75,15 @@ func validateValueData(path []string, arg *blueprint.NamedArg, value interface{}
 type ValuesDataKind string
 
 const (
-	JSON ValuesDataKind = "json"
-	YAML ValuesDataKind = "yaml"
+	JSON   ValuesDataKind = "json"
+	YAML   ValuesDataKind = "yaml"
+	TOML   ValuesDataKind = "toml"
+	DotEnv ValuesDataKind = "env"
 )
 
+// StdinValuesPath is the values file path meaning that values data should be read from the standard input.
+const StdinValuesPath = "-"
+
 type ValuesData struct {
 	Kind ValuesDataKind
 	Data []byte
@@ -83,6 +93,27 @@ func ReadValuesData(args blueprint.Args, valuesData *ValuesData) (ArgsValues, er
 	if valuesData == nil {
 		return nil, nil
 	}
+	if valuesData.Kind == DotEnv {
+		keysValues, err := readDotEnv(valuesData.Data)
+		if err != nil {
+			return nil, err
+		}
+		return ParseValues(args, keysValues)
+	}
+	values, err := unmarshalValuesData(valuesData)
+	if err != nil {
+		return nil, err
+	}
+	// built-in namespace keeps values of sub-templates, they are read with TemplateValuesData
+	delete(values, blueprint.BuiltinArgName)
+	argsValues, err := validateValuesData(args, values)
+	if err != nil {
+		return nil, err
+	}
+	return argsValues, nil
+}
+
+func unmarshalValuesData(valuesData *ValuesData) (map[string]interface{}, error) {
 	values := map[string]interface{}{}
 	switch valuesData.Kind {
 	case JSON:
@@ -97,26 +128,228 @@ func ReadValuesData(args blueprint.Args, valuesData *ValuesData) (ArgsValues, er
 			return nil, err
 		}
 		break
+	case TOML:
+		err := toml.Unmarshal(valuesData.Data, &values)
+		if err != nil {
+			return nil, err
+		}
+		break
 	}
-	argsValues, err := validateValuesData(args, values)
-	if err != nil {
-		return nil, err
+	return values, nil
+}
+
+// TemplatesValuesKey is the key in the built-in namespace of values data keeping values of sub-templates by their keys:
+// rendr.templates.api.name is the value of argument name of sub-template with key api.
+const TemplatesValuesKey = "templates"
+
+// TemplateValuesData returns values data of the sub-template nested into the values data under rendr.templates.
+// Values in .env format can't have sub-templates values and are skipped.
+func TemplateValuesData(valuesDataList []ValuesData, key string) ([]ValuesData, error) {
+	result := []ValuesData{}
+	for index := range valuesDataList {
+		if valuesDataList[index].Kind == DotEnv {
+			continue
+		}
+		values, err := unmarshalValuesData(&valuesDataList[index])
+		if err != nil {
+			return nil, err
+		}
+		builtin, _ := values[blueprint.BuiltinArgName].(map[string]interface{})
+		templates, _ := builtin[TemplatesValuesKey].(map[string]interface{})
+		templateValues, found := templates[key].(map[string]interface{})
+		if !found {
+			continue
+		}
+		data, err := json.Marshal(templateValues)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, ValuesData{JSON, data})
 	}
-	return argsValues, nil
+	return result, nil
+}
+
+// ReadValuesMap reads arguments values from the map in the same shape as values data, like preset values.
+func ReadValuesMap(args blueprint.Args, values map[string]interface{}) (ArgsValues, error) {
+	return validateValuesData(args, values)
+}
+
+// ReadValuesDataList reads all values data in the order of the list, later values are deep-merged over earlier ones.
+func ReadValuesDataList(args blueprint.Args, valuesDataList []ValuesData) (ArgsValues, error) {
+	var result ArgsValues = nil
+	for index := range valuesDataList {
+		argsValues, err := ReadValuesData(args, &valuesDataList[index])
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			result = argsValues
+		} else {
+			result, err = OverrideValues(args, result, argsValues)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+// LoadValuesFiles loads values data from all files in the order of paths.
+func LoadValuesFiles(valuesFilesPaths []string) ([]ValuesData, error) {
+	result := []ValuesData{}
+	for _, valuesFilePath := range valuesFilesPaths {
+		valuesData, err := LoadValuesFile(valuesFilePath)
+		if err != nil {
+			return nil, err
+		}
+		if valuesData != nil {
+			result = append(result, *valuesData)
+		}
+	}
+	return result, nil
 }
 
+// LoadValuesFile loads values data from the file, the format is picked by the file extension.
+// Data read from the standard input ("-" path) or from the file with unknown extension is sniffed for the format.
 func LoadValuesFile(valuesFilePath string) (*ValuesData, error) {
-	var valuesData *ValuesData = nil
-	if valuesFilePath != "" {
-		data, err := ioutil.ReadFile(valuesFilePath)
+	if valuesFilePath == "" {
+		return nil, nil
+	}
+	if valuesFilePath == StdinValuesPath {
+		data, err := io.ReadAll(os.Stdin)
 		if err != nil {
-			return nil, fmt.Errorf(`can't open file "%s": %s`, valuesFilePath, err.Error())
+			return nil, fmt.Errorf(`can't read values from stdin: %s`, err.Error())
+		}
+		return &ValuesData{SniffValuesDataKind(data), data}, nil
+	}
+	data, err := ioutil.ReadFile(valuesFilePath)
+	if err != nil {
+		return nil, fmt.Errorf(`can't open file "%s": %s`, valuesFilePath, err.Error())
+	}
+	valuesDataKind := valuesDataKindByPath(valuesFilePath)
+	if valuesDataKind == "" {
+		valuesDataKind = SniffValuesDataKind(data)
+	}
+	return &ValuesData{valuesDataKind, data}, nil
+}
+
+// SaveValuesFile writes values in the shape of values data to the file in the format picked by the file extension, YAML by default.
+// Values could be saved in JSON, YAML or TOML format.
+// The file could be loaded back with LoadValuesFile to render the template with the same values.
+func SaveValuesFile(valuesFilePath string, values map[string]interface{}) error {
+	valuesDataKind := valuesDataKindByPath(valuesFilePath)
+	if valuesDataKind == "" {
+		valuesDataKind = YAML
+	}
+	valuesData, err := WriteValuesData(valuesDataKind, values)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(valuesFilePath, valuesData.Data, 0644)
+	if err != nil {
+		return fmt.Errorf(`can't write file "%s": %s`, valuesFilePath, err.Error())
+	}
+	return nil
+}
+
+// WriteValuesData writes values in the shape of values data (see ValuesDataMap) into values data readable by ReadValuesData.
+func WriteValuesData(kind ValuesDataKind, values map[string]interface{}) (*ValuesData, error) {
+	var data []byte = nil
+	var err error = nil
+	switch kind {
+	case JSON:
+		data, err = json.MarshalIndent(values, "", "  ")
+		data = append(data, '\n')
+	case YAML:
+		data, err = yaml.Marshal(values)
+	case TOML:
+		buffer := &bytes.Buffer{}
+		err = toml.NewEncoder(buffer).Encode(values)
+		data = buffer.Bytes()
+	case DotEnv:
+		return nil, fmt.Errorf(`values can't be written in .env format as it can't keep strings and arrays values precisely, use json, yaml or toml`)
+	default:
+		return nil, fmt.Errorf(`unknown values data kind: "%s"`, kind)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &ValuesData{kind, data}, nil
+}
+
+// ValuesDataMap converts raw (not enriched) arguments values into the shape of values data.
+// Computed arguments and arguments without values are skipped.
+func ValuesDataMap(args blueprint.Args, argsValues ArgsValues) map[string]interface{} {
+	result := map[string]interface{}{}
+	for _, arg := range args {
+		value, found := argsValues[arg.Name]
+		if !found || value == nil {
+			continue
+		}
+		if arg.String != nil && arg.String.Computed() {
+			continue
+		}
+		if arg.Map != nil {
+			if mapValues, isMap := value.(ArgsValues); isMap {
+				result[arg.Name] = ValuesDataMap(arg.Map.Args, mapValues)
+			}
+			continue
+		}
+		result[arg.Name] = value
+	}
+	return result
+}
+
+func valuesDataKindByPath(valuesFilePath string) ValuesDataKind {
+	switch strings.ToLower(filepath.Ext(valuesFilePath)) {
+	case ".json":
+		return JSON
+	case ".yaml", ".yml":
+		return YAML
+	case ".toml":
+		return TOML
+	case ".env":
+		return DotEnv
+	}
+	return ""
+}
+
+// SniffValuesDataKind detects format of values data by trying to parse it as JSON, TOML and YAML mapping in this order.
+// Data that is none of these is treated as .env file.
+func SniffValuesDataKind(data []byte) ValuesDataKind {
+	if json.Valid(data) {
+		return JSON
+	}
+	values := map[string]interface{}{}
+	if toml.Unmarshal(data, &values) == nil {
+		return TOML
+	}
+	values = map[string]interface{}{}
+	if yaml.Unmarshal(data, &values) == nil {
+		return YAML
+	}
+	return DotEnv
+}
+
+// readDotEnv reads .env data into "name=value" pairs, names are arguments full names, like versions.foo.
+// Empty lines and comments are skipped, "export" prefix and quotes around value are dropped.
+func readDotEnv(data []byte) ([]string, error) {
+	result := []string{}
+	for index, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		name, value, found := strings.Cut(line, "=")
+		if !found {
+			return nil, fmt.Errorf(`line %d of .env values should be in format "name=value", found: "%s"`, index+1, line)
 		}
-		valuesDataKind := JSON
-		if strings.HasSuffix(valuesFilePath, ".yaml") || strings.HasSuffix(valuesFilePath, ".yml") {
-			valuesDataKind = YAML
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
 		}
-		valuesData = &ValuesData{valuesDataKind, data}
+		result = append(result, fmt.Sprintf(`%s=%s`, strings.TrimSpace(name), value))
 	}
-	return valuesData, nil
+	return result, nil
 }
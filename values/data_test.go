@@ -237,6 +237,83 @@ param:
 	},
 }
 
+var casesReadValuesToml = []ReadValuesTestCase{
+	{
+		"toml flat args",
+		blueprint.Args{
+			blueprint.NamedStringArg("param1", "", false, "", nil, nil),
+			blueprint.NamedBooleanArg("param2", "", false, "", nil),
+			blueprint.NamedArrayArg("param3", "", false, "", []string{"one", "two"}, nil),
+		},
+		TOML,
+		`
+param1 = "value1"
+param2 = true
+param3 = ["one", "two"]
+`,
+		nil,
+		ArgsValues{"param1": "value1", "param2": true, "param3": []string{"one", "two"}},
+	},
+	{
+		"toml nested arg",
+		blueprint.Args{
+			blueprint.NamedGroupArg("param", "", false, "", blueprint.Args{
+				blueprint.NamedStringArg("nested", "", false, "", nil, nil),
+			}),
+		},
+		TOML,
+		`
+[param]
+nested = "the_value"
+`,
+		nil,
+		ArgsValues{"param": ArgsValues{"nested": "the_value"}},
+	},
+}
+
+var casesReadValuesDotEnv = []ReadValuesTestCase{
+	{
+		"env flat args",
+		blueprint.Args{
+			blueprint.NamedStringArg("param1", "", false, "", nil, nil),
+			blueprint.NamedBooleanArg("param2", "", false, "", nil),
+			blueprint.NamedArrayArg("param3", "", false, "", []string{"one", "two"}, nil),
+		},
+		DotEnv,
+		`
+# comment
+param1="value 1"
+export param2=yes
+
+param3=one,two
+`,
+		nil,
+		ArgsValues{"param1": "value 1", "param2": true, "param3": []string{"one", "two"}},
+	},
+	{
+		"env nested arg",
+		blueprint.Args{
+			blueprint.NamedGroupArg("param", "", false, "", blueprint.Args{
+				blueprint.NamedStringArg("nested", "", false, "", nil, nil),
+			}),
+		},
+		DotEnv,
+		`param.nested='the_value'`,
+		nil,
+		ArgsValues{"param": ArgsValues{"nested": "the_value"}},
+	},
+	{
+		"env wrong line",
+		blueprint.Args{
+			blueprint.NamedStringArg("param", "", false, "", nil, nil),
+		},
+		DotEnv,
+		`param`,
+		errors.New(`line 1 of .env values should be in format "name=value", found: "param"`),
+		nil,
+	},
+}
+
 func ExecuteReadValuesTestCases(t *testing.T, testCases []ReadValuesTestCase) {
 	for _, testcase := range testCases {
 		t.Logf(`Running test case: %s`, testcase.Name)
@@ -255,6 +332,8 @@ func ExecuteReadValuesTestCases(t *testing.T, testCases []ReadValuesTestCase) {
 func Test_ReadValues(t *testing.T) {
 	ExecuteReadValuesTestCases(t, casesReadValuesJson)
 	ExecuteReadValuesTestCases(t, casesReadValuesYaml)
+	ExecuteReadValuesTestCases(t, casesReadValuesToml)
+	ExecuteReadValuesTestCases(t, casesReadValuesDotEnv)
 }
 
 type ReadValuesTestCase struct {
@@ -265,3 +344,125 @@ type ReadValuesTestCase struct {
 	Error    error
 	Expected ArgsValues
 }
+
+func Test_ReadValuesDataList(t *testing.T) {
+	args := blueprint.Args{
+		blueprint.NamedStringArg("name", "", false, "", nil, nil),
+		blueprint.NamedGroupArg("versions", "", false, "", blueprint.Args{
+			blueprint.NamedStringArg("foo", "", false, "", nil, nil),
+			blueprint.NamedStringArg("bar", "", false, "", nil, nil),
+		}),
+	}
+	valuesDataList := []ValuesData{
+		{YAML, []byte("name: org\nversions:\n  foo: 1.0.0\n  bar: 1.0.0")},
+		{JSON, []byte(`{"versions":{"bar":"2.0.0"}}`)},
+		{DotEnv, []byte(`name=service`)},
+	}
+	argsValues, err := ReadValuesDataList(args, valuesDataList)
+	assert.NilError(t, err)
+	expected := ArgsValues{"name": "service", "versions": ArgsValues{"foo": "1.0.0", "bar": "2.0.0"}}
+	assert.DeepEqual(t, argsValues, expected)
+}
+
+var casesSniffValuesDataKind = []SniffValuesDataKindTestCase{
+	{"json", `{"foo": "the foo", "bar": true}`, JSON},
+	{"toml", "foo = \"the foo\"\n[versions]\nfoo = \"1.0.0\"", TOML},
+	{"yaml", "foo: the foo\nversions:\n  foo: 1.0.0", YAML},
+	{"env", "foo=the foo\nversions.foo=1.0.0", DotEnv},
+}
+
+func Test_SniffValuesDataKind(t *testing.T) {
+	for _, testcase := range casesSniffValuesDataKind {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		assert.Equal(t, SniffValuesDataKind([]byte(testcase.Data)), testcase.Expected)
+	}
+}
+
+type SniffValuesDataKindTestCase struct {
+	Name     string
+	Data     string
+	Expected ValuesDataKind
+}
+
+func Test_WriteValuesData(t *testing.T) {
+	args := blueprint.Args{
+		blueprint.NamedStringArg("name", "", false, "", nil, nil),
+		blueprint.NamedComputedArg("title", "", "", "{{name.value}}"),
+		blueprint.NamedBooleanArg("docker", "", false, "", nil),
+		blueprint.NamedArrayArg("features", "", false, "", []string{"one", "two"}, nil),
+		blueprint.NamedStringArg("registry", "", false, "docker.value", nil, nil),
+		blueprint.NamedGroupArg("versions", "", false, "", blueprint.Args{
+			blueprint.NamedStringArg("foo", "", false, "", nil, nil),
+		}),
+	}
+	argsValues := ArgsValues{
+		"name":     "the name",
+		"title":    "the name",
+		"docker":   false,
+		"features": []string{"one", "two"},
+		"versions": ArgsValues{"foo": "1.0.0"},
+	}
+	expected := ArgsValues{
+		"name":     "the name",
+		"docker":   false,
+		"features": []string{"one", "two"},
+		"versions": ArgsValues{"foo": "1.0.0"},
+	}
+	for _, kind := range []ValuesDataKind{JSON, YAML, TOML} {
+		t.Logf(`Running test case: %s`, kind)
+		valuesData, err := WriteValuesData(kind, ValuesDataMap(args, argsValues))
+		assert.NilError(t, err)
+		values, err := ReadValuesData(args, valuesData)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, values, expected)
+	}
+}
+
+func Test_WriteValuesDataDotEnv(t *testing.T) {
+	args := blueprint.Args{
+		blueprint.NamedStringArg("name", "", false, "", nil, nil),
+	}
+	_, err := WriteValuesData(DotEnv, ValuesDataMap(args, ArgsValues{"name": "the name"}))
+	assert.Error(t, err, `values can't be written in .env format as it can't keep strings and arrays values precisely, use json, yaml or toml`)
+}
+
+func Test_WriteValuesDataYaml(t *testing.T) {
+	args := blueprint.Args{
+		blueprint.NamedStringArg("name", "", false, "", nil, nil),
+		blueprint.NamedGroupArg("versions", "", false, "", blueprint.Args{
+			blueprint.NamedStringArg("foo", "", false, "", nil, nil),
+		}),
+	}
+	valuesData, err := WriteValuesData(YAML, ValuesDataMap(args, ArgsValues{"name": "the name", "versions": ArgsValues{"foo": "1.0.0"}}))
+	assert.NilError(t, err)
+	expected := `
+name: the name
+versions:
+    foo: 1.0.0
+`
+	assert.Equal(t, string(valuesData.Data), strings.TrimLeft(expected, "\n"))
+}
+
+func Test_TemplateValuesData(t *testing.T) {
+	args := blueprint.Args{
+		blueprint.NamedStringArg("name", "", false, "", nil, nil),
+	}
+	valuesDataList := []ValuesData{
+		{YAML, []byte("name: parent\nrendr:\n  templates:\n    api:\n      name: api")},
+		{TOML, []byte("name = \"other\"")},
+		{DotEnv, []byte("name=env")},
+	}
+	argsValues, err := ReadValuesDataList(args, valuesDataList)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, argsValues, ArgsValues{"name": "env"})
+
+	templateValuesData, err := TemplateValuesData(valuesDataList, "api")
+	assert.NilError(t, err)
+	templateValues, err := ReadValuesDataList(args, templateValuesData)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, templateValues, ArgsValues{"name": "api"})
+
+	missingValuesData, err := TemplateValuesData(valuesDataList, "web")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, missingValuesData, []ValuesData{})
+}
@@ -43,7 +43,8 @@ func packBoolValue(boolValue bool) map[string]interface{} {
 }
 
 func packStringValue(possibleValues []string, stringValue string) map[string]interface{} {
-	valueObj := map[string]interface{}{"value": stringValue}
+	valueObj := caseVariants(stringValue)
+	valueObj["value"] = stringValue
 	if possibleValues != nil {
 		for _, possibleValue := range possibleValues {
 			valueObj[possibleValue] = stringValue == possibleValue
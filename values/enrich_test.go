@@ -14,7 +14,18 @@ var enrichValues = []EnrichTestCase{
 		},
 		ArgsValues{"param": "the value"},
 		ArgsValues{
-			"param": map[string]interface{}{"value": "the value"},
+			"param": map[string]interface{}{
+				"value":        "the value",
+				"camel":        "theValue",
+				"pascal":       "TheValue",
+				"snake":        "the_value",
+				"kebab":        "the-value",
+				"upper_snake":  "THE_VALUE",
+				"lower":        "the value",
+				"upper":        "THE VALUE",
+				"title":        "The Value",
+				"dot_to_slash": "the value",
+			},
 		},
 	},
 	{
@@ -34,7 +45,20 @@ var enrichValues = []EnrichTestCase{
 		},
 		ArgsValues{"param": "value2"},
 		ArgsValues{
-			"param": map[string]interface{}{"value": "value2", "value1": false, "value2": true},
+			"param": map[string]interface{}{
+				"value":        "value2",
+				"value1":       false,
+				"value2":       true,
+				"camel":        "value2",
+				"pascal":       "Value2",
+				"snake":        "value2",
+				"kebab":        "value2",
+				"upper_snake":  "VALUE2",
+				"lower":        "value2",
+				"upper":        "VALUE2",
+				"title":        "Value2",
+				"dot_to_slash": "value2",
+			},
 		},
 	},
 	{
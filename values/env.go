@@ -0,0 +1,45 @@
+package values
+
+import (
+	"fmt"
+	"github.com/specgen-io/rendr/blueprint"
+	"strings"
+)
+
+const EnvPrefix = "RENDR_ARG_"
+
+type LookupEnv func(name string) (string, bool)
+
+// EnvValues reads arguments values from environment variables.
+// The variable name is set with env field of the argument or derived from the argument full name:
+// versions.foo is read from RENDR_ARG_VERSIONS__FOO, double underscore separates nested names.
+// Values are parsed in the same way as values set in the command line.
+func EnvValues(args blueprint.Args, lookupEnv LookupEnv) (ArgsValues, error) {
+	keysValues := envKeysValues([]string{}, args, lookupEnv)
+	return ParseValues(args, keysValues)
+}
+
+func envKeysValues(path []string, args blueprint.Args, lookupEnv LookupEnv) []string {
+	result := []string{}
+	for _, arg := range args {
+		argPath := append(append([]string{}, path...), arg.Name)
+		if arg.Map != nil {
+			result = append(result, envKeysValues(argPath, arg.Map.Args, lookupEnv)...)
+			continue
+		}
+		if value, found := lookupEnv(EnvName(argPath, &arg)); found {
+			result = append(result, fmt.Sprintf(`%s=%s`, strings.Join(argPath, "."), value))
+		}
+	}
+	return result
+}
+
+// EnvName returns name of environment variable the argument value is read from.
+func EnvName(argPath []string, arg *blueprint.NamedArg) string {
+	if arg.Env != "" {
+		return arg.Env
+	}
+	name := strings.Join(argPath, "__")
+	name = strings.ReplaceAll(name, "-", "_")
+	return EnvPrefix + strings.ToUpper(name)
+}
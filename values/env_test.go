@@ -0,0 +1,79 @@
+package values
+
+import (
+	"errors"
+	"github.com/google/go-cmp/cmp"
+	"github.com/specgen-io/rendr/blueprint"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+var envTestArgs = blueprint.Args{
+	blueprint.NamedStringArg("name", "", false, "", nil, nil),
+	blueprint.NamedBooleanArg("docker", "", false, "", nil),
+	blueprint.NamedArrayArg("features", "", false, "", []string{"one", "two"}, nil),
+	blueprint.NamedGroupArg("versions", "", false, "", blueprint.Args{
+		blueprint.NamedStringArg("foo", "", false, "", nil, nil),
+		blueprint.NamedStringArg("kotlin-lang", "", false, "", nil, nil),
+	}),
+	{Name: "java", Arg: blueprint.Arg{Type: blueprint.ArgTypeString, Env: "JAVA_VERSION", String: &blueprint.ArgString{}}},
+}
+
+var casesEnvValues = []EnvValuesTestCase{
+	{
+		"no env",
+		map[string]string{},
+		nil,
+		ArgsValues{},
+	},
+	{
+		"flat args",
+		map[string]string{"RENDR_ARG_NAME": "the name", "RENDR_ARG_DOCKER": "yes", "RENDR_ARG_FEATURES": "one,two"},
+		nil,
+		ArgsValues{"name": "the name", "docker": true, "features": []string{"one", "two"}},
+	},
+	{
+		"nested args",
+		map[string]string{"RENDR_ARG_VERSIONS__FOO": "1.2.3", "RENDR_ARG_VERSIONS__KOTLIN_LANG": "1.7.0"},
+		nil,
+		ArgsValues{"versions": ArgsValues{"foo": "1.2.3", "kotlin-lang": "1.7.0"}},
+	},
+	{
+		"env name",
+		map[string]string{"JAVA_VERSION": "17", "RENDR_ARG_JAVA": "11"},
+		nil,
+		ArgsValues{"java": "17"},
+	},
+	{
+		"wrong boolean",
+		map[string]string{"RENDR_ARG_DOCKER": "maybe"},
+		errors.New(`strconv.ParseBool: parsing "maybe": invalid syntax`),
+		nil,
+	},
+}
+
+func Test_EnvValues(t *testing.T) {
+	for _, testcase := range casesEnvValues {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		lookupEnv := func(name string) (string, bool) {
+			value, found := testcase.Env[name]
+			return value, found
+		}
+		values, err := EnvValues(envTestArgs, lookupEnv)
+		if testcase.Error != nil {
+			assert.Error(t, err, testcase.Error.Error())
+		} else {
+			assert.NilError(t, err)
+		}
+		if !cmp.Equal(testcase.Expected, values) {
+			t.Errorf("Failed, values do not match\nexpected: %s\nactual:   %s", testcase.Expected, values)
+		}
+	}
+}
+
+type EnvValuesTestCase struct {
+	Name     string
+	Env      map[string]string
+	Error    error
+	Expected ArgsValues
+}
@@ -0,0 +1,128 @@
+package values
+
+import (
+	"github.com/cbroglie/mustache"
+	"github.com/specgen-io/rendr/blueprint"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+type escapeFunc func(value string) string
+
+func getEscapeFunc(escape blueprint.Escape) escapeFunc {
+	switch escape {
+	case blueprint.EscapeJson, blueprint.EscapeYaml:
+		return escapeJson
+	case blueprint.EscapeXml:
+		return escapeXml
+	case blueprint.EscapeShell:
+		return escapeShell
+	}
+	return nil
+}
+
+func escapeJson(value string) string {
+	escaped, err := jsonEscape(value)
+	if err != nil {
+		return value
+	}
+	return escaped
+}
+
+func escapeHtml(value string) string {
+	return template.HTMLEscapeString(value)
+}
+
+var xmlReplacer = strings.NewReplacer(`&`, "&amp;", `<`, "&lt;", `>`, "&gt;", `"`, "&quot;", `'`, "&apos;")
+
+func escapeXml(value string) string {
+	return xmlReplacer.Replace(value)
+}
+
+var shellSafe = regexp.MustCompile(`^[a-zA-Z0-9_@%+=:,./-]+$`)
+
+func escapeShell(value string) string {
+	if shellSafe.MatchString(value) {
+		return value
+	}
+	return `'` + strings.ReplaceAll(value, `'`, `'"'"'`) + `'`
+}
+
+// escapeValue leaves blank values as they are: mustache treats them as false in sections
+// and escaping would turn them into non-empty strings, e.g. quotes for shell.
+func escapeValue(value string, escape escapeFunc) string {
+	if strings.TrimSpace(value) == "" {
+		return value
+	}
+	return escape(value)
+}
+
+func escapeValues(value interface{}, escape escapeFunc) interface{} {
+	switch typed := value.(type) {
+	case string:
+		return escapeValue(typed, escape)
+	case []string:
+		result := make([]string, len(typed))
+		for index := range typed {
+			result[index] = escapeValue(typed[index], escape)
+		}
+		return result
+	case ArgsValues:
+		result := ArgsValues{}
+		for key, item := range typed {
+			result[key] = escapeValues(item, escape)
+		}
+		return result
+	case map[string]interface{}:
+		result := map[string]interface{}{}
+		for key, item := range typed {
+			result[key] = escapeValues(item, escape)
+		}
+		return result
+	}
+	return value
+}
+
+// rawTags turns all escaped variables tags of the template into unescaped ones: {{name}} becomes {{&name}}.
+// Used for templates parsed by mustache internally (partials and lambdas) as they are always HTML escaped.
+func rawTags(template string) string {
+	var builder strings.Builder
+	for {
+		openIndex := strings.Index(template, "{{")
+		if openIndex == -1 {
+			builder.WriteString(template)
+			return builder.String()
+		}
+		closeTag := "}}"
+		if strings.HasPrefix(template[openIndex:], "{{{") {
+			closeTag = "}}}"
+		}
+		closeIndex := strings.Index(template[openIndex:], closeTag)
+		if closeIndex == -1 {
+			builder.WriteString(template)
+			return builder.String()
+		}
+		closeIndex += openIndex
+		tag := strings.TrimSpace(template[openIndex+2 : closeIndex])
+		builder.WriteString(template[:openIndex])
+		if closeTag == "}}" && tag != "" && !strings.ContainsAny(tag[0:1], "#^/>!={&") {
+			builder.WriteString("{{&" + tag + "}}")
+		} else {
+			builder.WriteString(template[openIndex : closeIndex+len(closeTag)])
+		}
+		template = template[closeIndex+len(closeTag):]
+	}
+}
+
+type rawPartials struct {
+	partials mustache.PartialProvider
+}
+
+func (p *rawPartials) Get(name string) (string, error) {
+	partial, err := p.partials.Get(name)
+	if err != nil {
+		return "", err
+	}
+	return rawTags(partial), nil
+}
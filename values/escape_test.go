@@ -0,0 +1,63 @@
+package values
+
+import (
+	"github.com/cbroglie/mustache"
+	"github.com/specgen-io/rendr/blueprint"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+var escapeValuesTestData = ArgsValues{
+	"name":  map[string]interface{}{"value": `Tom & "Jerry's"`},
+	"empty": map[string]interface{}{"value": ""},
+}
+
+var casesMustacheEscape = []MustacheEscapeTestCase{
+	{"default html", "", "{{name.value}}", "Tom &amp; &#34;Jerry&#39;s&#34;"},
+	{"html", blueprint.EscapeHtml, "{{name.value}}", "Tom &amp; &#34;Jerry&#39;s&#34;"},
+	{"none", blueprint.EscapeNone, "{{name.value}}", `Tom & "Jerry's"`},
+	{"json", blueprint.EscapeJson, `{"name": "{{name.value}}"}`, `{"name": "Tom & \"Jerry's\""}`},
+	{"yaml", blueprint.EscapeYaml, `name: "{{name.value}}"`, `name: "Tom & \"Jerry's\""`},
+	{"xml", blueprint.EscapeXml, "<name>{{name.value}}</name>", "<name>Tom &amp; &quot;Jerry&apos;s&quot;</name>"},
+	{"shell", blueprint.EscapeShell, "echo {{name.value}}", `echo 'Tom & "Jerry'"'"'s"'`},
+	{"none in helper", blueprint.EscapeNone, "{{#upper}}{{name.value}}{{/upper}}", `TOM & "JERRY'S"`},
+	{"html in helper", blueprint.EscapeHtml, "{{#upper}}{{name.value}}{{/upper}}", "TOM &amp; &#34;JERRY&#39;S&#34;"},
+	{"html text in helper", blueprint.EscapeHtml, "{{#upper}}Tom & Jerry{{/upper}}", "TOM &amp; JERRY"},
+	{"none in partial", blueprint.EscapeNone, "{{> partial}}", `name: Tom & "Jerry's"`},
+	{"html empty section", blueprint.EscapeHtml, "run{{#empty.value}} {{empty.value}}{{/empty.value}}", "run"},
+	{"none empty section", blueprint.EscapeNone, "run{{#empty.value}} {{empty.value}}{{/empty.value}}", "run"},
+	{"json empty section", blueprint.EscapeJson, "run{{#empty.value}} {{empty.value}}{{/empty.value}}", "run"},
+	{"yaml empty section", blueprint.EscapeYaml, "run{{#empty.value}} {{empty.value}}{{/empty.value}}", "run"},
+	{"xml empty section", blueprint.EscapeXml, "run{{#empty.value}} {{empty.value}}{{/empty.value}}", "run"},
+	{"shell empty section", blueprint.EscapeShell, "run{{#empty.value}} {{empty.value}}{{/empty.value}}", "run"},
+}
+
+func Test_MustacheEscape(t *testing.T) {
+	partials := &mustache.StaticProvider{Partials: map[string]string{"partial": "name: {{name.value}}"}}
+	for _, testcase := range casesMustacheEscape {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		engine := Mustache{Partials: partials, Escape: testcase.Escape}
+		result, err := engine.Render(testcase.Template, escapeValuesTestData)
+		assert.NilError(t, err)
+		assert.Equal(t, result, testcase.Expected)
+	}
+}
+
+type MustacheEscapeTestCase struct {
+	Name     string
+	Escape   blueprint.Escape
+	Template string
+	Expected string
+}
+
+func Test_RawTags(t *testing.T) {
+	actual := rawTags("{{a}} {{{b}}} {{#c}}{{ d }}{{/c}} {{> e}} {{&f}}")
+	assert.Equal(t, actual, "{{&a}} {{{b}}} {{#c}}{{&d}}{{/c}} {{> e}} {{&f}}")
+}
+
+func Test_MustacheDelimiters(t *testing.T) {
+	engine := Mustache{Escape: blueprint.EscapeNone, Delimiters: "<% %>"}
+	result, err := engine.Render("\nname: <% name.value %>\nimage: {{ .Values.image }}\n<%#upper%>upper<%/upper%>", escapeValuesTestData)
+	assert.NilError(t, err)
+	assert.Equal(t, result, "\nname: Tom & \"Jerry's\"\nimage: {{ .Values.image }}\nUPPER")
+}
@@ -0,0 +1,287 @@
+package values
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
+)
+
+// EvaluateCondition evaluates boolean expression over enriched arguments values.
+// Supported operators are: and, or, not, ==, !=, in and parentheses.
+// Operands could be references to values (like build.value), string literals in single or double quotes,
+// true/false literals and lists of operands in square brackets.
+func EvaluateCondition(expression string, argsValues ArgsValues) (bool, error) {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return false, fmt.Errorf(`failed to parse condition "%s": %s`, expression, err.Error())
+	}
+	parser := &expressionParser{tokens, 0, argsValues}
+	value, err := parser.parseOr()
+	if err != nil {
+		return false, fmt.Errorf(`failed to evaluate condition "%s": %s`, expression, err.Error())
+	}
+	if parser.position < len(parser.tokens) {
+		return false, fmt.Errorf(`failed to parse condition "%s": unexpected "%s"`, expression, parser.tokens[parser.position].text)
+	}
+	return isTruthy(value), nil
+}
+
+type tokenKind int
+
+const (
+	tokenIdentifier tokenKind = iota
+	tokenString
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+}
+
+func tokenize(expression string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(expression)
+	for index := 0; index < len(runes); {
+		r := runes[index]
+		switch {
+		case unicode.IsSpace(r):
+			index++
+		case r == '"' || r == '\'':
+			end := index + 1
+			for end < len(runes) && runes[end] != r {
+				end++
+			}
+			if end >= len(runes) {
+				return nil, fmt.Errorf(`unterminated string literal`)
+			}
+			tokens = append(tokens, token{tokenString, string(runes[index+1 : end])})
+			index = end + 1
+		case r == '=' || r == '!':
+			if index+1 >= len(runes) || runes[index+1] != '=' {
+				return nil, fmt.Errorf(`unexpected "%c"`, r)
+			}
+			tokens = append(tokens, token{tokenOperator, string(runes[index : index+2])})
+			index += 2
+		case r == '(' || r == ')' || r == '[' || r == ']' || r == ',':
+			tokens = append(tokens, token{tokenOperator, string(r)})
+			index++
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-':
+			end := index
+			for end < len(runes) && (unicode.IsLetter(runes[end]) || unicode.IsDigit(runes[end]) || runes[end] == '_' || runes[end] == '.' || runes[end] == '-') {
+				end++
+			}
+			tokens = append(tokens, token{tokenIdentifier, string(runes[index:end])})
+			index = end
+		default:
+			return nil, fmt.Errorf(`unexpected "%c"`, r)
+		}
+	}
+	return tokens, nil
+}
+
+type expressionParser struct {
+	tokens     []token
+	position   int
+	argsValues ArgsValues
+}
+
+func (p *expressionParser) peek() *token {
+	if p.position < len(p.tokens) {
+		return &p.tokens[p.position]
+	}
+	return nil
+}
+
+func (p *expressionParser) isNext(kind tokenKind, text string) bool {
+	next := p.peek()
+	return next != nil && next.kind == kind && next.text == text
+}
+
+func (p *expressionParser) expect(text string) error {
+	if !p.isNext(tokenOperator, text) {
+		return fmt.Errorf(`expected "%s"`, text)
+	}
+	p.position++
+	return nil
+}
+
+func (p *expressionParser) parseOr() (interface{}, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.isNext(tokenIdentifier, "or") {
+		p.position++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = isTruthy(left) || isTruthy(right)
+	}
+	return left, nil
+}
+
+func (p *expressionParser) parseAnd() (interface{}, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for p.isNext(tokenIdentifier, "and") {
+		p.position++
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		left = isTruthy(left) && isTruthy(right)
+	}
+	return left, nil
+}
+
+func (p *expressionParser) parseNot() (interface{}, error) {
+	if p.isNext(tokenIdentifier, "not") {
+		p.position++
+		value, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return !isTruthy(value), nil
+	}
+	return p.parseComparison()
+}
+
+func (p *expressionParser) parseComparison() (interface{}, error) {
+	left, err := p.parseOperand()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case p.isNext(tokenOperator, "=="):
+		p.position++
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return isEqual(left, right), nil
+	case p.isNext(tokenOperator, "!="):
+		p.position++
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return !isEqual(left, right), nil
+	case p.isNext(tokenIdentifier, "in"):
+		p.position++
+		right, err := p.parseOperand()
+		if err != nil {
+			return nil, err
+		}
+		return isIn(left, right), nil
+	}
+	return left, nil
+}
+
+func (p *expressionParser) parseOperand() (interface{}, error) {
+	next := p.peek()
+	if next == nil {
+		return nil, fmt.Errorf(`unexpected end of expression`)
+	}
+	p.position++
+	if next.kind == tokenString {
+		return next.text, nil
+	}
+	if next.kind == tokenOperator {
+		switch next.text {
+		case "(":
+			value, err := p.parseOr()
+			if err != nil {
+				return nil, err
+			}
+			return value, p.expect(")")
+		case "[":
+			items := []interface{}{}
+			for !p.isNext(tokenOperator, "]") {
+				if len(items) > 0 {
+					err := p.expect(",")
+					if err != nil {
+						return nil, err
+					}
+				}
+				item, err := p.parseOperand()
+				if err != nil {
+					return nil, err
+				}
+				items = append(items, item)
+			}
+			return items, p.expect("]")
+		}
+		return nil, fmt.Errorf(`unexpected "%s"`, next.text)
+	}
+	switch next.text {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "and", "or", "not", "in":
+		return nil, fmt.Errorf(`unexpected "%s"`, next.text)
+	}
+	return LookupValue(p.argsValues, next.text)
+}
+
+// LookupValue returns the value referenced by the dotted path like "name.kebab".
+func LookupValue(argsValues ArgsValues, reference string) (interface{}, error) {
+	var current interface{} = argsValues
+	for _, name := range strings.Split(reference, ".") {
+		var value interface{} = nil
+		found := false
+		switch currentMap := current.(type) {
+		case ArgsValues:
+			value, found = currentMap[name]
+		case map[string]interface{}:
+			value, found = currentMap[name]
+		}
+		if !found {
+			return nil, fmt.Errorf(`missing variable "%s"`, reference)
+		}
+		current = value
+	}
+	return current, nil
+}
+
+func isTruthy(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+	switch typed := value.(type) {
+	case bool:
+		return typed
+	case string:
+		return typed != ""
+	}
+	reflected := reflect.ValueOf(value)
+	switch reflected.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return reflected.Len() > 0
+	}
+	return true
+}
+
+func isEqual(left, right interface{}) bool {
+	return fmt.Sprint(left) == fmt.Sprint(right)
+}
+
+func isIn(item, collection interface{}) bool {
+	if collectionString, isString := collection.(string); isString {
+		return strings.Contains(collectionString, fmt.Sprint(item))
+	}
+	reflected := reflect.ValueOf(collection)
+	if reflected.Kind() == reflect.Slice || reflected.Kind() == reflect.Array {
+		for index := 0; index < reflected.Len(); index++ {
+			if isEqual(item, reflected.Index(index).Interface()) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package values
+
+import (
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+var conditionValues = ArgsValues{
+	"build":    map[string]interface{}{"value": "gradle", "gradle": true, "maven": false},
+	"docker":   map[string]interface{}{"value": true, "is_true": true, "is_false": false},
+	"features": map[string]interface{}{"value": []string{"helloworld", "exit"}, "values": []string{"helloworld", "exit"}},
+	"empty":    map[string]interface{}{"value": ""},
+}
+
+var casesEvaluateCondition = []EvaluateConditionTestCase{
+	{"reference", `docker.value`, true, ""},
+	{"equals double quotes", `build.value == "gradle"`, true, ""},
+	{"equals single quotes", `build.value == 'maven'`, false, ""},
+	{"not equals", `build.value != "maven"`, true, ""},
+	{"and not", `build.value == "gradle" and not docker.is_false`, true, ""},
+	{"or", `build.maven or docker.is_false`, false, ""},
+	{"parentheses", `not (build.maven or docker.is_false)`, true, ""},
+	{"precedence", `build.maven and docker.value or build.gradle`, true, ""},
+	{"in list", `build.value in ["maven", "gradle"]`, true, ""},
+	{"not in list", `not build.value in ['maven', 'sbt']`, true, ""},
+	{"in array value", `"exit" in features.value`, true, ""},
+	{"empty string", `empty.value`, false, ""},
+	{"boolean literal", `docker.value == true`, true, ""},
+	{"missing variable", `build.sbt`, false, `failed to evaluate condition "build.sbt": missing variable "build.sbt"`},
+	{"unclosed parentheses", `(build.gradle`, false, `failed to evaluate condition "(build.gradle": expected ")"`},
+	{"unterminated string", `build.value == "gradle`, false, `failed to parse condition "build.value == "gradle": unterminated string literal`},
+	{"trailing tokens", `build.gradle docker.value`, false, `failed to parse condition "build.gradle docker.value": unexpected "docker.value"`},
+}
+
+func Test_EvaluateCondition(t *testing.T) {
+	for _, testcase := range casesEvaluateCondition {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		result, err := EvaluateCondition(testcase.Expression, conditionValues)
+		if testcase.Error != "" {
+			assert.Error(t, err, testcase.Error)
+		} else {
+			assert.NilError(t, err)
+			assert.Equal(t, result, testcase.Expected)
+		}
+	}
+}
+
+type EvaluateConditionTestCase struct {
+	Name       string
+	Expression string
+	Expected   bool
+	Error      string
+}
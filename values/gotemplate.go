@@ -0,0 +1,143 @@
+package values
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/specgen-io/rendr/blueprint"
+	"html"
+	"reflect"
+	"strings"
+	gotemplate "text/template"
+)
+
+type GoTemplate struct {
+	Escape     blueprint.Escape
+	Delimiters blueprint.Delimiters
+}
+
+func (g GoTemplate) Render(template string, argsValues ArgsValues) (string, error) {
+	tmpl := gotemplate.New("template").Option("missingkey=error").Funcs(goTemplateFuncs())
+	if g.Delimiters != "" {
+		delimiters := strings.Split(string(g.Delimiters), " ")
+		tmpl = tmpl.Delims(delimiters[0], delimiters[1])
+	}
+	parsed, err := tmpl.Parse(template)
+	if err != nil {
+		return "", err
+	}
+	escape := getEscapeFunc(g.Escape)
+	if g.Escape == blueprint.EscapeHtml {
+		escape = html.EscapeString
+	}
+	if escape != nil {
+		argsValues = escapeValues(argsValues, escape).(ArgsValues)
+	}
+	buffer := &bytes.Buffer{}
+	err = parsed.Execute(buffer, argsValues)
+	if err != nil {
+		return "", err
+	}
+	return buffer.String(), nil
+}
+
+// RenderShort renders file or folder name, the name rendered to empty string is excluded.
+// Conditions expressions are supported in the same way as for Mustache: {{?docker.value}}Dockerfile
+func (g GoTemplate) RenderShort(template string, argsValues ArgsValues) (*string, error) {
+	if strings.HasPrefix(template, "{{?") {
+		return renderConditional(g, template, argsValues)
+	}
+	result, err := g.Render(template, argsValues)
+	if err != nil {
+		return nil, err
+	}
+	if result == "" && template != "" {
+		return nil, nil
+	}
+	return &result, nil
+}
+
+func goTemplateFuncs() gotemplate.FuncMap {
+	funcs := gotemplate.FuncMap{
+		"upper":      strings.ToUpper,
+		"lower":      strings.ToLower,
+		"title":      func(s string) string { return titleCase(splitWords(s)) },
+		"trim":       strings.TrimSpace,
+		"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+		"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+		"replace":    func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+		"contains":   func(substr, s string) bool { return strings.Contains(s, substr) },
+		"hasPrefix":  func(prefix, s string) bool { return strings.HasPrefix(s, prefix) },
+		"hasSuffix":  func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+		"repeat":     func(count int, s string) string { return strings.Repeat(s, count) },
+		"add":        func(a, b int) int { return a + b },
+		"add1":       func(a int) int { return a + 1 },
+		"quote":      func(s interface{}) string { return fmt.Sprintf("%q", fmt.Sprint(s)) },
+		"squote":     func(s interface{}) string { return fmt.Sprintf("'%v'", s) },
+		"indent":     func(spaces int, s string) string { return indent(strings.Repeat(" ", spaces))(s) },
+		"nindent":    func(spaces int, s string) string { return "\n" + indent(strings.Repeat(" ", spaces))(s) },
+		"camelcase":  func(s string) string { return camelCase(splitWords(s)) },
+		"pascalcase": func(s string) string { return pascalCase(splitWords(s)) },
+		"snakecase":  func(s string) string { return strings.ToLower(strings.Join(splitWords(s), "_")) },
+		"kebabcase":  func(s string) string { return strings.ToLower(strings.Join(splitWords(s), "-")) },
+		"list":       func(items ...interface{}) []interface{} { return items },
+		"join":       func(sep string, list interface{}) string { return strings.Join(toStrings(list), sep) },
+		"splitList":  func(sep, s string) []string { return strings.Split(s, sep) },
+		"has":        func(needle interface{}, list interface{}) bool { return isIn(needle, list) },
+		"default":    func(def interface{}, given ...interface{}) interface{} { return defaultValueOf(def, given...) },
+		"empty":      func(given interface{}) bool { return !isTruthy(given) },
+		"ternary":    func(vt, vf interface{}, condition bool) interface{} { return ternary(vt, vf, condition) },
+		"toJson":     toJson,
+		"b64enc":     func(s string) string { return base64.StdEncoding.EncodeToString([]byte(s)) },
+		"b64dec":     b64dec,
+		"sha256sum":  func(s string) string { hash := sha256.Sum256([]byte(s)); return hex.EncodeToString(hash[:]) },
+	}
+	for name, helper := range helpers {
+		funcs[name] = helper
+	}
+	return funcs
+}
+
+func toStrings(list interface{}) []string {
+	result := []string{}
+	reflected := reflect.ValueOf(list)
+	if reflected.Kind() == reflect.Slice || reflected.Kind() == reflect.Array {
+		for index := 0; index < reflected.Len(); index++ {
+			result = append(result, fmt.Sprint(reflected.Index(index).Interface()))
+		}
+	}
+	return result
+}
+
+func defaultValueOf(def interface{}, given ...interface{}) interface{} {
+	if len(given) == 0 || !isTruthy(given[0]) {
+		return def
+	}
+	return given[0]
+}
+
+func ternary(vt, vf interface{}, condition bool) interface{} {
+	if condition {
+		return vt
+	}
+	return vf
+}
+
+func toJson(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func b64dec(s string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
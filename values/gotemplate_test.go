@@ -0,0 +1,68 @@
+package values
+
+import (
+	"github.com/specgen-io/rendr/blueprint"
+	"gotest.tools/v3/assert"
+	"testing"
+)
+
+var goTemplateValues = ArgsValues{
+	"name":     map[string]interface{}{"value": "my-service", "pascal": "MyService"},
+	"docker":   map[string]interface{}{"value": true},
+	"features": map[string]interface{}{"value": []string{"helloworld", "exit"}},
+}
+
+var casesGoTemplateRender = []GoTemplateRenderTestCase{
+	{"value", GoTemplate{}, "class {{.name.pascal}}", "class MyService", ""},
+	{"if", GoTemplate{}, "{{if .docker.value}}FROM alpine{{end}}", "FROM alpine", ""},
+	{"range", GoTemplate{}, "{{range .features.value}}[{{.}}]{{end}}", "[helloworld][exit]", ""},
+	{"functions", GoTemplate{}, `{{.name.value | upper}} {{join ", " .features.value}} {{has "exit" .features.value}}`, "MY-SERVICE helloworld, exit true", ""},
+	{"case functions", GoTemplate{}, `{{.name.value | snakecase}} {{.name.value | camelcase}}`, "my_service myService", ""},
+	{"default", GoTemplate{}, `{{"" | default "none"}}`, "none", ""},
+	{"helpers", GoTemplate{}, `{{.name.value | plural}}`, "my-services", ""},
+	{"delimiters", GoTemplate{Delimiters: "[[ ]]"}, "[[.name.value]] {{ .Values.image }}", "my-service {{ .Values.image }}", ""},
+	{"missing key", GoTemplate{}, "{{.name.missing}}", "", `template: template:1:7: executing "template" at <.name.missing>: map has no entry for key "missing"`},
+}
+
+func Test_GoTemplateRender(t *testing.T) {
+	for _, testcase := range casesGoTemplateRender {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		result, err := testcase.Engine.Render(testcase.Template, goTemplateValues)
+		if testcase.Error != "" {
+			assert.Error(t, err, testcase.Error)
+		} else {
+			assert.NilError(t, err)
+			assert.Equal(t, result, testcase.Expected)
+		}
+	}
+}
+
+type GoTemplateRenderTestCase struct {
+	Name     string
+	Engine   GoTemplate
+	Template string
+	Expected string
+	Error    string
+}
+
+func Test_GoTemplateEscape(t *testing.T) {
+	engine := GoTemplate{Escape: blueprint.EscapeJson}
+	result, err := engine.Render(`{"name": "{{.name.value}}"}`, ArgsValues{"name": map[string]interface{}{"value": `say "hi"`}})
+	assert.NilError(t, err)
+	assert.Equal(t, result, `{"name": "say \"hi\""}`)
+}
+
+func Test_GoTemplateRenderShort(t *testing.T) {
+	engine := GoTemplate{}
+	result, err := engine.RenderShort("{{if .docker.value}}Dockerfile{{end}}", goTemplateValues)
+	assert.NilError(t, err)
+	assert.Equal(t, *result, "Dockerfile")
+
+	result, err = engine.RenderShort("{{if not .docker.value}}Dockerfile{{end}}", goTemplateValues)
+	assert.NilError(t, err)
+	assert.Assert(t, result == nil)
+
+	result, err = engine.RenderShort("{{?docker.value}}", goTemplateValues)
+	assert.NilError(t, err)
+	assert.Equal(t, *result, "")
+}
@@ -0,0 +1,125 @@
+package values
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/cbroglie/mustache"
+	"sort"
+	"strings"
+)
+
+type Helper func(text string) (string, error)
+
+var helpers = map[string]Helper{
+	"upper":       simpleHelper(strings.ToUpper),
+	"lower":       simpleHelper(strings.ToLower),
+	"trim":        simpleHelper(strings.TrimSpace),
+	"indent2":     simpleHelper(indent("  ")),
+	"indent4":     simpleHelper(indent("    ")),
+	"json_escape": jsonEscape,
+	"yaml_quote":  yamlQuote,
+	"base64":      simpleHelper(func(text string) string { return base64.StdEncoding.EncodeToString([]byte(text)) }),
+	"sha256":      simpleHelper(func(text string) string { hash := sha256.Sum256([]byte(text)); return hex.EncodeToString(hash[:]) }),
+	"plural":      simpleHelper(plural),
+}
+
+func RegisterHelper(name string, helper Helper) {
+	helpers[name] = helper
+}
+
+// HelperNames returns names of all registered helpers sorted.
+func HelperNames() []string {
+	names := []string{}
+	for name := range helpers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// helpersContext returns helpers as lambdas, escape is applied to the helper result, it is nil when values are escaped upfront.
+func helpersContext(escape escapeFunc) map[string]interface{} {
+	context := map[string]interface{}{}
+	for name, helper := range helpers {
+		context[name] = lambda(helper, escape)
+	}
+	return context
+}
+
+// lambda renders the section content without escaping, transforms it by the helper and only then escapes the result,
+// so helper never sees escaped text: {{#upper}}Tom & Jerry{{/upper}} is TOM &amp; JERRY in HTML.
+func lambda(helper Helper, escape escapeFunc) mustache.LambdaFunc {
+	return func(text string, render mustache.RenderFunc) (string, error) {
+		rendered, err := render(rawTags(text))
+		if err != nil {
+			return "", err
+		}
+		result, err := helper(rendered)
+		if err != nil {
+			return "", err
+		}
+		if escape != nil {
+			result = escape(result)
+		}
+		return result, nil
+	}
+}
+
+func simpleHelper(transform func(text string) string) Helper {
+	return func(text string) (string, error) {
+		return transform(text), nil
+	}
+}
+
+func indent(prefix string) func(text string) string {
+	return func(text string) string {
+		lines := strings.Split(text, "\n")
+		for index, line := range lines {
+			if strings.TrimSpace(line) != "" {
+				lines[index] = prefix + line
+			}
+		}
+		return strings.Join(lines, "\n")
+	}
+}
+
+func jsonQuote(text string) (string, error) {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+	err := encoder.Encode(text)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buffer.String(), "\n"), nil
+}
+
+func jsonEscape(text string) (string, error) {
+	quoted, err := jsonQuote(text)
+	if err != nil {
+		return "", err
+	}
+	return quoted[1 : len(quoted)-1], nil
+}
+
+func yamlQuote(text string) (string, error) {
+	return jsonQuote(text)
+}
+
+func plural(text string) string {
+	word := strings.TrimSpace(text)
+	lower := strings.ToLower(word)
+	switch {
+	case word == "":
+		return text
+	case strings.HasSuffix(lower, "s") || strings.HasSuffix(lower, "x") || strings.HasSuffix(lower, "z") || strings.HasSuffix(lower, "ch") || strings.HasSuffix(lower, "sh"):
+		return word + "es"
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !strings.ContainsAny(lower[len(lower)-2:len(lower)-1], "aeiou"):
+		return word[:len(word)-1] + "ies"
+	default:
+		return word + "s"
+	}
+}
@@ -0,0 +1,52 @@
+package values
+
+import (
+	"github.com/specgen-io/rendr/blueprint"
+	"gotest.tools/v3/assert"
+	"strings"
+	"testing"
+)
+
+var helpersValues = ArgsValues{
+	"model": map[string]interface{}{"value": "category"},
+}
+
+var casesHelpers = []HelpersTestCase{
+	{"upper", "{{#upper}}{{model.value}}{{/upper}}", "CATEGORY"},
+	{"lower", "{{#lower}}Some TEXT{{/lower}}", "some text"},
+	{"indent4", "{{#indent4}}line1\n\nline2{{/indent4}}", "    line1\n\n    line2"},
+	{"json_escape", `{"name": "{{#json_escape}}say "hi"{{/json_escape}}"}`, `{"name": "say \"hi\""}`},
+	{"yaml_quote", "name: {{#yaml_quote}}Tom & Jerry: \"the show\"{{/yaml_quote}}", `name: "Tom & Jerry: \"the show\""`},
+	{"base64", "{{#base64}}hello{{/base64}}", "aGVsbG8="},
+	{"sha256", "{{#sha256}}hello{{/sha256}}", "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"},
+	{"plural", "{{#plural}}{{model.value}}{{/plural}} {{#plural}}class{{/plural}} {{#plural}}user{{/plural}}", "categories classes users"},
+}
+
+func Test_Helpers(t *testing.T) {
+	for _, testcase := range casesHelpers {
+		t.Logf(`Running test case: %s`, testcase.Name)
+		result, err := Mustache{Escape: blueprint.EscapeNone}.Render(testcase.Template, helpersValues)
+		assert.NilError(t, err)
+		assert.Equal(t, result, testcase.Expected)
+	}
+}
+
+func Test_RegisterHelper(t *testing.T) {
+	RegisterHelper("reverse", func(text string) (string, error) {
+		runes := []rune(text)
+		for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+			runes[i], runes[j] = runes[j], runes[i]
+		}
+		return strings.TrimSpace(string(runes)), nil
+	})
+	t.Cleanup(func() { delete(helpers, "reverse") })
+	result, err := Render("{{#reverse}}{{model.value}}{{/reverse}}", helpersValues)
+	assert.NilError(t, err)
+	assert.Equal(t, result, "yrogetac")
+}
+
+type HelpersTestCase struct {
+	Name     string
+	Template string
+	Expected string
+}
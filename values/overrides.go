@@ -32,6 +32,9 @@ func OverrideValue(path []string, arg *blueprint.NamedArg, value, override ArgVa
 		return boolValue, nil
 	}
 	if arg.Array != nil {
+		if stringValues, isStringArray := override.([]string); isStringArray {
+			return stringValues, nil
+		}
 		arrayValues, isArray := override.([]interface{})
 		if !isArray {
 			return nil, errors.New(fmt.Sprintf(`argument "%s" should be array`, strings.Join(path, ".")))
@@ -38,6 +38,16 @@ var casesOverrideValues = []OverrideValuesTestCase{
 		ArgsValues{"param2": "the override"},
 		ArgsValues{"param1": "the value", "param2": "the override"},
 	},
+	{
+		"array arg",
+		blueprint.Args{
+			blueprint.NamedArrayArg("param1", "", false, "", nil, nil),
+			blueprint.NamedArrayArg("param2", "", false, "", nil, nil),
+		},
+		ArgsValues{"param1": []string{"one"}, "param2": []string{"two"}},
+		ArgsValues{"param2": []string{"three", "four"}},
+		ArgsValues{"param1": []string{"one"}, "param2": []string{"three", "four"}},
+	},
 	{
 		"nested arg",
 		blueprint.Args{
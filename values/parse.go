@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/specgen-io/rendr/blueprint"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -27,7 +28,26 @@ func ParseValues(args blueprint.Args, values []string) (ArgsValues, error) {
 	return result, nil
 }
 
-func setValue(arg *blueprint.NamedArg, argsValues ArgsValues, path []string, argValue string) error {
+// ParseTypedValues sets values by arguments paths like "service.name", string values are parsed according to
+// the argument type same way as in ParseValues while typed values (arrays, booleans) are kept as they are.
+func ParseTypedValues(args blueprint.Args, values map[string]interface{}) (ArgsValues, error) {
+	rootArg := blueprint.NamedGroupArg("", "", false, "", args)
+	keys := []string{}
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	result := ArgsValues{}
+	for _, key := range keys {
+		err := setValue(&rootArg, result, strings.Split(key, "."), values[key])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func setValue(arg *blueprint.NamedArg, argsValues ArgsValues, path []string, value interface{}) error {
 	currentValues := argsValues
 	for pathIndex := range path {
 		argName := path[pathIndex]
@@ -41,6 +61,14 @@ func setValue(arg *blueprint.NamedArg, argsValues ArgsValues, path []string, arg
 		arg = nextArg
 
 		if pathIndex == len(path)-1 {
+			if boolValue, isBool := value.(bool); isBool && arg.String != nil {
+				value = strconv.FormatBool(boolValue)
+			}
+			argValue, isString := value.(string)
+			if !isString {
+				currentValues[argName] = value
+				return nil
+			}
 			if arg.Array != nil {
 				argValues := strings.Split(argValue, ",")
 				currentValues[argName] = argValues
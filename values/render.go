@@ -3,20 +3,53 @@ package values
 import (
 	"fmt"
 	"github.com/cbroglie/mustache"
+	"github.com/specgen-io/rendr/blueprint"
 	"strings"
 )
 
-func Render(template string, argsValues ArgsValues) (string, error) {
+type Engine interface {
+	Render(template string, argsValues ArgsValues) (string, error)
+	RenderShort(template string, argsValues ArgsValues) (*string, error)
+}
+
+type Mustache struct {
+	Partials   mustache.PartialProvider
+	Escape     blueprint.Escape
+	Delimiters blueprint.Delimiters
+}
+
+func (m Mustache) Render(template string, argsValues ArgsValues) (string, error) {
 	mustache.AllowMissingVariables = false
-	content, err := mustache.Render(template, argsValues)
+	if m.Delimiters != "" && m.Delimiters != blueprint.DefaultDelimiters {
+		// set delimiter tag followed by new line is standalone and the new line is not rendered
+		template = fmt.Sprintf("{{=%s=}}\n%s", m.Delimiters, template)
+	}
+	if m.Escape == "" || m.Escape == blueprint.EscapeHtml {
+		content, err := mustache.RenderPartials(template, m.Partials, argsValues, helpersContext(escapeHtml))
+		if err != nil {
+			return "", err
+		}
+		return content, nil
+	}
+	var partials mustache.PartialProvider = nil
+	if m.Partials != nil {
+		partials = &rawPartials{m.Partials}
+	}
+	escape := getEscapeFunc(m.Escape)
+	if escape != nil {
+		argsValues = escapeValues(argsValues, escape).(ArgsValues)
+	}
+	content, err := mustache.RenderPartialsRaw(template, partials, true, argsValues, helpersContext(nil))
 	if err != nil {
 		return "", err
 	}
 	return content, nil
 }
 
-func RenderShort(template string, argsValues ArgsValues) (*string, error) {
-	if strings.HasPrefix(template, "{{#") || strings.HasPrefix(template, "{{^") {
+func (m Mustache) RenderShort(template string, argsValues ArgsValues) (*string, error) {
+	if strings.HasPrefix(template, "{{?") {
+		return renderConditional(m, template, argsValues)
+	} else if strings.HasPrefix(template, "{{#") || strings.HasPrefix(template, "{{^") {
 		closeIndex := strings.Index(template, "}}")
 		formula := template[:closeIndex+2]
 		internal := template[closeIndex+2:]
@@ -25,12 +58,12 @@ func RenderShort(template string, argsValues ArgsValues) (*string, error) {
 		}
 		fullTemplate := closeFormula(formula, internal)
 
-		_, err := Render(fmt.Sprintf(`{{%s}}`, getArgument(formula)), argsValues)
+		_, err := m.Render(fmt.Sprintf(`{{%s}}`, getArgument(formula)), argsValues)
 		if err != nil {
 			return nil, err
 		}
 
-		result, err := Render(fullTemplate, argsValues)
+		result, err := m.Render(fullTemplate, argsValues)
 		if err != nil {
 			return nil, err
 		}
@@ -43,7 +76,7 @@ func RenderShort(template string, argsValues ArgsValues) (*string, error) {
 		}
 		return &result, nil
 	} else {
-		result, err := Render(template, argsValues)
+		result, err := m.Render(template, argsValues)
 		if err != nil {
 			return nil, err
 		}
@@ -51,6 +84,57 @@ func RenderShort(template string, argsValues ArgsValues) (*string, error) {
 	}
 }
 
+func Render(template string, argsValues ArgsValues) (string, error) {
+	return Mustache{}.Render(template, argsValues)
+}
+
+func RenderShort(template string, argsValues ArgsValues) (*string, error) {
+	return Mustache{}.RenderShort(template, argsValues)
+}
+
+func NewEngine(kind blueprint.EngineKind) Engine {
+	if kind == blueprint.EngineGoTemplate {
+		return GoTemplate{}
+	}
+	return Mustache{}
+}
+
+// NewRawEngine returns engine of the kind rendering values without any escaping.
+func NewRawEngine(kind blueprint.EngineKind) Engine {
+	if kind == blueprint.EngineGoTemplate {
+		return GoTemplate{Escape: blueprint.EscapeNone}
+	}
+	return Mustache{Escape: blueprint.EscapeNone}
+}
+
+func renderRaw(template string, argsValues ArgsValues) (string, error) {
+	mustache.AllowMissingVariables = false
+	content, err := mustache.RenderRaw(template, true, argsValues, helpersContext(nil))
+	if err != nil {
+		return "", err
+	}
+	return content, nil
+}
+
+func renderConditional(engine Engine, template string, argsValues ArgsValues) (*string, error) {
+	closeIndex := strings.Index(template, "}}")
+	if closeIndex == -1 {
+		return nil, fmt.Errorf(`condition "%s" is not closed`, template)
+	}
+	condition, err := EvaluateCondition(template[3:closeIndex], argsValues)
+	if err != nil {
+		return nil, err
+	}
+	if !condition {
+		return nil, nil
+	}
+	result, err := engine.Render(template[closeIndex+2:], argsValues)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
 func closeFormula(formula string, internal string) string {
 	argument := getArgument(formula)
 	formulaClosing := fmt.Sprintf(`{{/%s}}`, argument)
@@ -65,6 +65,34 @@ var casesRenderShort = []RenderShortTestCase{
 		nil,
 		nil,
 	},
+	{
+		"condition expression true with string",
+		"{{?myparam.value == 'thevalue' and not other.value}}some string",
+		ArgsValues{
+			"myparam": map[string]interface{}{"value": "thevalue"},
+			"other":   map[string]interface{}{"value": false},
+		},
+		StrPtr("some string"),
+		nil,
+	},
+	{
+		"condition expression false",
+		"{{?myparam.value != 'thevalue'}}some string",
+		ArgsValues{
+			"myparam": map[string]interface{}{"value": "thevalue"},
+		},
+		nil,
+		nil,
+	},
+	{
+		"condition expression true no string",
+		"{{?myparam.value in ['one', 'thevalue']}}",
+		ArgsValues{
+			"myparam": map[string]interface{}{"value": "thevalue"},
+		},
+		StrPtr(""),
+		nil,
+	},
 }
 
 func Test_RenderShort(t *testing.T) {
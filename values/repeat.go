@@ -0,0 +1,34 @@
+package values
+
+import (
+	"fmt"
+)
+
+// RepeatValues returns values for each element of the array referenced by the repeat path syntax: {{*services}}.
+// The element is pushed on top of the values: string element is available as {{value}} with all naming variants,
+// like {{kebab}} or {{pascal}}.
+func RepeatValues(reference string, argsValues ArgsValues) ([]ArgsValues, error) {
+	value, err := LookupValue(argsValues, reference)
+	if err != nil {
+		return nil, err
+	}
+	if enriched, isMap := value.(map[string]interface{}); isMap {
+		value = enriched["value"]
+	}
+	elements, isArray := value.([]string)
+	if !isArray {
+		return nil, fmt.Errorf(`repeat "%s" references value that is not an array`, reference)
+	}
+	result := []ArgsValues{}
+	for _, element := range elements {
+		values := ArgsValues{}
+		for name, value := range argsValues {
+			values[name] = value
+		}
+		for name, value := range packStringValue(nil, element) {
+			values[name] = value
+		}
+		result = append(result, values)
+	}
+	return result, nil
+}
@@ -3,6 +3,7 @@ package values
 import (
 	"fmt"
 	"github.com/specgen-io/rendr/blueprint"
+	"strings"
 )
 
 type ArgValue interface{}
@@ -10,10 +11,16 @@ type ArgsValues map[string]ArgValue
 
 type ArgValueGetter func(arg blueprint.NamedArg) (ArgValue, error)
 
-func GetValues(args blueprint.Args, forceInput bool, noInput bool, argsValues ArgsValues, getter ArgValueGetter) (ArgsValues, error) {
+func GetValues(args blueprint.Args, forceInput bool, noInput bool, argsValues ArgsValues, engine Engine, getter ArgValueGetter) (ArgsValues, error) {
+	return GetValuesWithBuiltins(args, forceInput, noInput, argsValues, nil, engine, getter)
+}
+
+// GetValuesWithBuiltins gets arguments values in the same way as GetValues,
+// built-in values are available to conditions, defaults and computed values of arguments: {{rendr.year}}
+func GetValuesWithBuiltins(args blueprint.Args, forceInput bool, noInput bool, argsValues ArgsValues, builtins ArgsValues, engine Engine, getter ArgValueGetter) (ArgsValues, error) {
 	values := ArgsValues{}
 	for _, arg := range args {
-		condition, err := computeCondition(args, values, arg.Condition)
+		condition, err := computeCondition(engine, args, values, builtins, arg.Condition)
 		if err != nil {
 			return nil, err
 		}
@@ -25,13 +32,26 @@ func GetValues(args blueprint.Args, forceInput bool, noInput bool, argsValues Ar
 			if value == nil {
 				value = ArgsValues{}
 			}
-			mapValue, err := GetValues(arg.Map.Args, forceInput, noInput || arg.NoInput, value.(ArgsValues), getter)
+			mapValue, err := GetValuesWithBuiltins(arg.Map.Args, forceInput, noInput || arg.NoInput, value.(ArgsValues), builtins, engine, getter)
 			if err != nil {
 				return nil, err
 			}
 			value = mapValue
+		} else if arg.String != nil && arg.String.Computed() {
+			if value != nil {
+				return nil, fmt.Errorf(`argument "%s" is computed and can't have value provided for it`, arg.Name)
+			}
+			computedValue, err := computeValue(args, values, builtins, *arg.String.Value)
+			if err != nil {
+				return nil, fmt.Errorf(`failed to compute argument "%s": %s`, arg.Name, err.Error())
+			}
+			value = computedValue
 		} else {
 			if value == nil {
+				arg, err := renderDefault(args, values, builtins, arg)
+				if err != nil {
+					return nil, err
+				}
 				argValue, err := getValue(arg, forceInput, noInput, getter)
 				if err != nil {
 					return nil, err
@@ -44,14 +64,50 @@ func GetValues(args blueprint.Args, forceInput bool, noInput bool, argsValues Ar
 	return values, nil
 }
 
-func computeCondition(args blueprint.Args, values ArgsValues, condition string) (bool, error) {
-	result, err := RenderShort(condition, EnrichValues(args, values))
+func computeCondition(engine Engine, args blueprint.Args, values ArgsValues, builtins ArgsValues, condition string) (bool, error) {
+	return IsConditionTrue(engine, condition, enrichWithBuiltins(args, values, builtins))
+}
+
+func enrichWithBuiltins(args blueprint.Args, values ArgsValues, builtins ArgsValues) ArgsValues {
+	result := EnrichValues(args, values)
+	for name, value := range builtins {
+		result[name] = value
+	}
+	return result
+}
+
+// IsConditionTrue evaluates condition over enriched arguments values.
+// Condition is either expression (see EvaluateCondition) or template that is false when rendered short to nothing: {{#docker.value}}
+func IsConditionTrue(engine Engine, condition string, argsValues ArgsValues) (bool, error) {
+	condition = strings.TrimSpace(condition)
+	if condition != "" && !strings.HasPrefix(condition, "{{") {
+		return EvaluateCondition(condition, argsValues)
+	}
+	result, err := engine.RenderShort(condition, argsValues)
 	if err != nil {
 		return false, err
 	}
 	return result != nil, nil
 }
 
+func computeValue(args blueprint.Args, values ArgsValues, builtins ArgsValues, expression string) (string, error) {
+	return renderRaw(expression, enrichWithBuiltins(args, values, builtins))
+}
+
+func renderDefault(args blueprint.Args, values ArgsValues, builtins ArgsValues, arg blueprint.NamedArg) (blueprint.NamedArg, error) {
+	if arg.String == nil || arg.String.Default == nil {
+		return arg, nil
+	}
+	renderedDefault, err := renderRaw(*arg.String.Default, enrichWithBuiltins(args, values, builtins))
+	if err != nil {
+		return arg, fmt.Errorf(`failed to render default of argument "%s": %s`, arg.Name, err.Error())
+	}
+	argString := *arg.String
+	argString.Default = &renderedDefault
+	arg.String = &argString
+	return arg, nil
+}
+
 func getValue(arg blueprint.NamedArg, forceInput bool, noInput bool, getter ArgValueGetter) (ArgValue, error) {
 	isStringArgWithSingleOption := arg.String != nil && len(arg.String.Values) == 1
 	shouldGet := (forceInput || (!noInput && !arg.NoInput)) && !isStringArgWithSingleOption
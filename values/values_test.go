@@ -110,12 +110,71 @@ var casesGetValues = []GetValuesTestCase{
 		HardcodedGetter("the value"),
 		ArgsValues{"param1": false, "param3": "the value"},
 	},
+	{
+		"computed arg",
+		blueprint.Args{
+			blueprint.NamedStringArg("param1", "", false, "", nil, nil),
+			blueprint.NamedComputedArg("param2", "", "", "{{param1.value}}-service"),
+		},
+		false,
+		false,
+		HardcodedGetter("the & value"),
+		ArgsValues{"param1": "the & value", "param2": "the & value-service"},
+	},
+	{
+		"computed arg in group",
+		blueprint.Args{
+			blueprint.NamedGroupArg("themap", "", false, "", blueprint.Args{
+				blueprint.NamedStringArg("param1", "", false, "", nil, nil),
+				blueprint.NamedComputedArg("param2", "", "", "{{param1.value}}.{{param1.value}}"),
+			}),
+		},
+		false,
+		false,
+		HardcodedGetter("the value"),
+		ArgsValues{"themap": ArgsValues{"param1": "the value", "param2": "the value.the value"}},
+	},
+	{
+		"templated default noinput",
+		blueprint.Args{
+			blueprint.NamedStringArg("name", "", false, "", nil, nil),
+			blueprint.NamedStringArg("package", "", true, "", nil, blueprint.StrPtr("com.acme.{{name.value}}")),
+		},
+		false,
+		false,
+		HardcodedGetter("app"),
+		ArgsValues{"name": "app", "package": "com.acme.app"},
+	},
+	{
+		"templated default suggested",
+		blueprint.Args{
+			blueprint.NamedStringArg("name", "", true, "", nil, blueprint.StrPtr("app")),
+			blueprint.NamedStringArg("package", "", false, "", nil, blueprint.StrPtr("com.acme.{{name.value}}")),
+		},
+		false,
+		false,
+		DefaultGetter(),
+		ArgsValues{"name": "app", "package": "com.acme.app"},
+	},
+	{
+		"conditional arg expression",
+		blueprint.Args{
+			blueprint.NamedStringArg("build", "", true, "", []string{"maven", "gradle"}, blueprint.StrPtr("gradle")),
+			blueprint.NamedBooleanArg("docker", "", true, "", BoolPtr(true)),
+			blueprint.NamedStringArg("param1", "", false, `build.value == "gradle" and not docker.is_false`, nil, nil),
+			blueprint.NamedStringArg("param2", "", false, `build.maven or not docker.value`, nil, nil),
+		},
+		false,
+		false,
+		HardcodedGetter("the value"),
+		ArgsValues{"build": "gradle", "docker": true, "param1": "the value"},
+	},
 }
 
 func Test_GetValues(t *testing.T) {
 	for _, testcase := range casesGetValues {
 		t.Logf(`Running test case: %s`, testcase.Name)
-		values, err := GetValues(testcase.Args, testcase.ForceInput, testcase.NoInput, ArgsValues{}, testcase.Getter)
+		values, err := GetValues(testcase.Args, testcase.ForceInput, testcase.NoInput, ArgsValues{}, Mustache{}, testcase.Getter)
 		assert.Equal(t, err, nil)
 		if !cmp.Equal(testcase.Expected, values) {
 			t.Errorf("\nexpected: %s\nactual:   %s", testcase.Expected, values)
@@ -123,6 +182,56 @@ func Test_GetValues(t *testing.T) {
 	}
 }
 
+func Test_GetValues_ComputedArgWithValue(t *testing.T) {
+	args := blueprint.Args{
+		blueprint.NamedComputedArg("param", "", "", "the value"),
+	}
+	_, err := GetValues(args, false, false, ArgsValues{"param": "provided"}, Mustache{}, HardcodedGetter("the value"))
+	assert.Error(t, err, `argument "param" is computed and can't have value provided for it`)
+}
+
+func Test_GetValues_GoTemplateCondition(t *testing.T) {
+	args := blueprint.Args{
+		blueprint.NamedBooleanArg("param1", "", true, "", BoolPtr(false)),
+		blueprint.NamedStringArg("param2", "", false, "{{if .param1.value}}true{{end}}", nil, nil),
+		blueprint.NamedStringArg("param3", "", false, "{{if not .param1.value}}true{{end}}", nil, nil),
+	}
+	values, err := GetValues(args, false, false, ArgsValues{}, GoTemplate{}, HardcodedGetter("the value"))
+	assert.NilError(t, err)
+	expected := ArgsValues{"param1": false, "param3": "the value"}
+	if !cmp.Equal(expected, values) {
+		t.Errorf("\nexpected: %s\nactual:   %s", expected, values)
+	}
+}
+
+func Test_GetValues_Builtins(t *testing.T) {
+	args := blueprint.Args{
+		blueprint.NamedStringArg("copyright", "", true, "", nil, blueprint.StrPtr("{{rendr.year}} ACME")),
+		blueprint.NamedComputedArg("author", "", "", "{{rendr.git.user.name}}"),
+	}
+	builtins := ArgsValues{"rendr": map[string]interface{}{"year": "2022", "git": map[string]interface{}{"user": map[string]interface{}{"name": "John"}}}}
+	values, err := GetValuesWithBuiltins(args, false, false, ArgsValues{}, builtins, Mustache{}, HardcodedGetter("the value"))
+	assert.NilError(t, err)
+	expected := ArgsValues{"copyright": "2022 ACME", "author": "John"}
+	if !cmp.Equal(expected, values) {
+		t.Errorf("\nexpected: %s\nactual:   %s", expected, values)
+	}
+}
+
+func Test_GetValues_ComputedArgWithHelper(t *testing.T) {
+	args := blueprint.Args{
+		blueprint.NamedStringArg("name", "", true, "", nil, blueprint.StrPtr("billing service")),
+		blueprint.NamedComputedArg("constant", "", "", "{{#upper}}{{name.snake}}{{/upper}}"),
+	}
+	assert.NilError(t, args.Validate(HelperNames()...))
+	values, err := GetValues(args, false, false, ArgsValues{}, Mustache{}, HardcodedGetter("the value"))
+	assert.NilError(t, err)
+	expected := ArgsValues{"name": "billing service", "constant": "BILLING_SERVICE"}
+	if !cmp.Equal(expected, values) {
+		t.Errorf("\nexpected: %s\nactual:   %s", expected, values)
+	}
+}
+
 type GetValuesTestCase struct {
 	Name       string
 	Args       blueprint.Args
@@ -132,6 +241,12 @@ type GetValuesTestCase struct {
 	Expected   ArgsValues
 }
 
+func DefaultGetter() ArgValueGetter {
+	return func(arg blueprint.NamedArg) (ArgValue, error) {
+		return *arg.String.Default, nil
+	}
+}
+
 func HardcodedGetter(value ArgValue) ArgValueGetter {
 	return func(arg blueprint.NamedArg) (ArgValue, error) {
 		return value, nil